@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"reflect"
 
@@ -67,6 +66,10 @@ func (s *AccessControlsServiceOp) List(ctx context.Context, id int, opt *ListOpt
 		return nil, nil, godo.NewArgError("id", "cannot be less than 1")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureAccessControls); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf(bucketAccessControlsBasePath, id) + apiFormat
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -94,13 +97,16 @@ func (s *AccessControlsServiceOp) Create(ctx context.Context, createRequest *Acc
 		return nil, nil, godo.NewArgError("createRequest", "cannot be nil")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureAccessControls); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf(bucketAccessControlsBasePath, createRequest.BucketID) + apiFormat
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createRequest)
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("AccessControl [Create]  req: ", req)
 
 	root := new(accessControlRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -117,6 +123,10 @@ func (s *AccessControlsServiceOp) Delete(ctx context.Context, deleteRequest *Acc
 		return nil, godo.NewArgError("bucketID", "cannot be less than 1")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureAccessControls); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf(bucketAccessControlsBasePath, deleteRequest.BucketID) + apiFormat
 	path, err := addOptions(path, meta)
 	if err != nil {
@@ -127,7 +137,6 @@ func (s *AccessControlsServiceOp) Delete(ctx context.Context, deleteRequest *Acc
 	if err != nil {
 		return nil, err
 	}
-	log.Println("AccessControl [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -138,13 +147,16 @@ func (s *AccessControlsServiceOp) Edit(ctx context.Context, editRequest *AccessC
 		return nil, godo.NewArgError("editRequest", "cannot be nil")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureAccessControls); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf(bucketAccessControlsBasePath, editRequest.BucketID) + apiFormat
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, editRequest)
 	if err != nil {
 		return nil, err
 	}
-	log.Println("AccessControl [Edit]  req: ", req)
 
 	root := new(accessControlRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -184,14 +196,8 @@ func (obj *AccessControl) equal(filter interface{}) bool {
 type Limits map[string]interface{}
 
 func LimitsRef(serverType string, resourceType string) *Limits {
-	log.Printf("AccessControl [LimitsRef]  serverType: '%s'  resourceType: '%s'", serverType, resourceType)
-
 	if st, ok := (*AccessControls)[serverType]; ok {
-		log.Printf("AccessControl [LimitsRef]  serverType: '%+v'", &st)
-
 		if rt, ok := (*st)[resourceType]; ok {
-			log.Printf("AccessControl [LimitsRef]  resourceType: '%+v'", &rt)
-
 			return rt
 		}
 	}
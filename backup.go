@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -160,7 +159,6 @@ func (s *BackupsServiceOp) Create(ctx context.Context, createRequest *BackupCrea
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("Backup [Create]  req: ", req)
 
 	root := new(backupRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -188,7 +186,6 @@ func (s *BackupsServiceOp) Delete(ctx context.Context, id int, meta interface{})
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Backup [Delete]  req: ", req)
 
 	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
@@ -242,7 +239,6 @@ func (s *BackupsServiceOp) BackupNote(ctx context.Context, id int, noteRequest *
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Backup [BackupNote]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -259,7 +255,6 @@ func (s *BackupsServiceOp) ConvertBackupToTemplate(ctx context.Context, id int,
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Backup [ConvertBackupToTemplate]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -141,7 +140,6 @@ func (s *BackupResourcesServiceOp) Create(ctx context.Context, createRequest *Ba
 		return nil, nil, err
 	}
 
-	log.Println("BackupResource [Create] req: ", req)
 
 	root := new(backupResourceRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -168,7 +166,6 @@ func (s *BackupResourcesServiceOp) Delete(ctx context.Context, id int, meta inte
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupResource [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -122,7 +121,6 @@ func (s *BackupResourceZonesServiceOp) Create(ctx context.Context, createRequest
 		return nil, nil, err
 	}
 
-	log.Println("BackupResourceZone [Create] req: ", req)
 
 	root := new(backupResourceZoneRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -149,7 +147,6 @@ func (s *BackupResourceZonesServiceOp) Delete(ctx context.Context, id int, meta
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupResourceZone [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
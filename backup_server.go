@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -161,7 +160,6 @@ func (s *BackupServersServiceOp) Create(ctx context.Context, createRequest *Back
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("BackupServer [Create] req: ", req)
 
 	root := new(backupServerRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -188,7 +186,6 @@ func (s *BackupServersServiceOp) Delete(ctx context.Context, id int, meta interf
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupServer [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -209,7 +206,6 @@ func (s *BackupServersServiceOp) Edit(ctx context.Context, id int, editRequest *
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupServer [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -226,7 +222,6 @@ func (s *BackupServersServiceOp) Refresh(ctx context.Context, resID int) (*Hardw
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("BackupServer [Refresh] req: ", req)
 
 	out := &rootHardware{}
 	resp, err := s.client.Do(ctx, req, out)
@@ -258,7 +253,6 @@ func (s *BackupServersServiceOp) Attach(ctx context.Context, resID int, attachRe
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupServer [Attach] req: ", req)
 
 	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
@@ -284,7 +278,6 @@ func (s *BackupServersServiceOp) EditIntegratedStorageSettings(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupServer [EditIntegratedStorageSettings]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -139,7 +138,6 @@ func (s *BackupServerGroupsServiceOp) Create(ctx context.Context, createRequest
 		return nil, nil, err
 	}
 
-	log.Println("BackupServerGroup [Create] req: ", req)
 
 	root := new(backupServerGroupRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -166,7 +164,6 @@ func (s *BackupServerGroupsServiceOp) Delete(ctx context.Context, id int, meta i
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupServerGroup [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -187,7 +184,6 @@ func (s *BackupServerGroupsServiceOp) Edit(ctx context.Context, id int, editRequ
 	if err != nil {
 		return nil, err
 	}
-	log.Println("BackupServerGroup [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -147,7 +146,6 @@ func (s *BackupServerJoinsServiceOp) Create(ctx context.Context, createRequest *
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("BackupServerJoin [Create] req: ", req)
 
 	root := new(backupServerJoinRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -185,7 +183,6 @@ func (s *BackupServerJoinsServiceOp) Delete(ctx context.Context, deleteRequest *
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("BackupServerJoin [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
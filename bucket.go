@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -70,6 +69,10 @@ func (d BucketCreateRequest) String() string {
 
 // List all Buckets.
 func (s *BucketsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Bucket, *Response, error) {
+	if err := s.client.requireFeature(ctx, FeatureBuckets); err != nil {
+		return nil, nil, err
+	}
+
 	path := bucketsBasePath + apiFormat
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -101,6 +104,10 @@ func (s *BucketsServiceOp) Get(ctx context.Context, id int) (*Bucket, *Response,
 		return nil, nil, godo.NewArgError("id", "cannot be less than 1")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureBuckets); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf("%s/%d%s", bucketsBasePath, id, apiFormat)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -123,6 +130,10 @@ func (s *BucketsServiceOp) Create(ctx context.Context, createRequest *BucketCrea
 		return nil, nil, godo.NewArgError("createRequest", "cannot be nil")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureBuckets); err != nil {
+		return nil, nil, err
+	}
+
 	path := bucketsBasePath + apiFormat
 
 	rootRequest := &bucketCreateRequestRoot{
@@ -133,7 +144,6 @@ func (s *BucketsServiceOp) Create(ctx context.Context, createRequest *BucketCrea
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("Bucket [Create]  req: ", req)
 
 	root := new(bucketRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -150,6 +160,10 @@ func (s *BucketsServiceOp) Delete(ctx context.Context, id int, meta interface{})
 		return nil, godo.NewArgError("id", "cannot be less than 1")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureBuckets); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("%s/%d%s", bucketsBasePath, id, apiFormat)
 	path, err := addOptions(path, meta)
 	if err != nil {
@@ -160,7 +174,6 @@ func (s *BucketsServiceOp) Delete(ctx context.Context, id int, meta interface{})
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Bucket [Delete]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -175,13 +188,16 @@ func (s *BucketsServiceOp) Edit(ctx context.Context, id int, editRequest *Bucket
 		return nil, godo.NewArgError("Bucket [Edit] editRequest", "cannot be nil")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureBuckets); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("%s/%d%s", bucketsBasePath, id, apiFormat)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, editRequest)
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Bucket [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -0,0 +1,277 @@
+package onappgo
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedService is the path of the endpoints of a service whose responses
+// can be cached, for example "templates" for ImageTemplatesService. Other
+// read-mostly endpoints can be cached with their path.
+type CachedService string
+
+// Services with read-mostly endpoints.
+const (
+	CachedImageTemplates   = CachedService(imageTemplatesBasePath)
+	CachedRemoteTemplates  = CachedService(remoteTemplatesBasePath)
+	CachedHypervisorGroups = CachedService(hypervisorGroupsBasePath)
+	CachedLocationGroups   = CachedService(locationGroupsBasePath)
+	CachedConfigurations   = CachedService(configurationBasePath)
+)
+
+// CacheOptions specifies the responses cached by the client.
+type CacheOptions struct {
+	// Time the GET responses of the endpoints of every service are fresh,
+	// the responses of the other endpoints are not cached
+	TTLs map[CachedService]time.Duration
+
+	// Maximum number of cached responses, unlimited if zero
+	MaxEntries int
+}
+
+// SetCache is a client option for caching the responses of read-mostly
+// endpoints, like ImageTemplatesService.List:
+//
+//	client, err := onappgo.New(nil, onappgo.SetCache(onappgo.CacheOptions{
+//		TTLs: map[onappgo.CachedService]time.Duration{
+//			onappgo.CachedImageTemplates:  10 * time.Minute,
+//			onappgo.CachedConfigurations: time.Hour,
+//		},
+//	}))
+//
+// Expired responses with an ETag are revalidated with If-None-Match. The
+// responses of a service are invalidated by the successful requests changing
+// it made with the same client, or by InvalidateCache.
+func SetCache(opts CacheOptions) ClientOpt {
+	return func(c *Client) error {
+		ttls := make(map[string]time.Duration, len(opts.TTLs))
+		for s, ttl := range opts.TTLs {
+			if ttl > 0 {
+				ttls[strings.Trim(string(s), "/")] = ttl
+			}
+		}
+
+		c.cache = &responseCache{
+			ttls:       ttls,
+			maxEntries: opts.MaxEntries,
+			entries:    map[string]*cacheEntry{},
+			now:        time.Now,
+		}
+		return nil
+	}
+}
+
+// InvalidateCache forgets the cached responses of the services, of all of
+// them if none is given.
+func (c *Client) InvalidateCache(services ...CachedService) {
+	if c.cache == nil {
+		return
+	}
+
+	if len(services) == 0 {
+		c.cache.invalidate(func(string) bool { return true })
+		return
+	}
+
+	for _, s := range services {
+		prefix := strings.Trim(string(s), "/")
+		c.cache.invalidate(func(service string) bool { return service == prefix })
+	}
+}
+
+type responseCache struct {
+	ttls       map[string]time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	service string
+	expires time.Time
+	etag    string
+
+	status int
+	header http.Header
+	body   []byte
+}
+
+// response returns a new response with the cached status, headers and body.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// service returns the cached service of the path relative to the base URL,
+// the longest matching one.
+func (rc *responseCache) service(path string) (string, time.Duration, bool) {
+	var service string
+	var ttl time.Duration
+	for s, t := range rc.ttls {
+		if pathWithin(path, s) && len(s) > len(service) {
+			service, ttl = s, t
+		}
+	}
+
+	return service, ttl, service != ""
+}
+
+// pathWithin reports whether the path is prefix or one of its sub paths.
+func pathWithin(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+func (rc *responseCache) get(key string) *cacheEntry {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return rc.entries[key]
+}
+
+func (rc *responseCache) put(key string, e *cacheEntry) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.entries[key]; !ok && rc.maxEntries > 0 && len(rc.entries) >= rc.maxEntries {
+		rc.evict()
+	}
+	rc.entries[key] = e
+}
+
+// evict removes the expired entries, or the entry expiring first if none
+// is expired.
+func (rc *responseCache) evict() {
+	now := rc.now()
+	var first string
+	for key, e := range rc.entries {
+		if now.After(e.expires) {
+			delete(rc.entries, key)
+			continue
+		}
+		if first == "" || e.expires.Before(rc.entries[first].expires) {
+			first = key
+		}
+	}
+
+	if len(rc.entries) >= rc.maxEntries {
+		delete(rc.entries, first)
+	}
+}
+
+func (rc *responseCache) invalidate(match func(service string) bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for key, e := range rc.entries {
+		if match(e.service) {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+// relativePath returns the path of the request relative to the base URL,
+// without the format extension.
+func (c *Client) relativePath(req *http.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+	return strings.TrimSuffix(strings.Trim(path, "/"), apiFormat)
+}
+
+// cacheKey identifies the response to a GET request of a user.
+func cacheKey(req *http.Request) string {
+	auth := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(auth[:]) + " " + req.URL.String()
+}
+
+// cacheMiddleware serves the fresh cached responses, revalidates the expired
+// ones and caches the new ones. The requests changing a cached service
+// invalidate its responses.
+func (c *Client) cacheMiddleware(next RoundTripFunc) RoundTripFunc {
+	if c.cache == nil {
+		return next
+	}
+
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		path := c.relativePath(req)
+
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			resp, err := next(ctx, req)
+			if err == nil && resp.StatusCode < http.StatusBadRequest {
+				c.cache.invalidate(func(service string) bool {
+					return pathWithin(path, service) || pathWithin(service, path)
+				})
+			}
+			return resp, err
+		}
+
+		service, ttl, ok := c.cache.service(path)
+		if !ok || req.Method != http.MethodGet {
+			return next(ctx, req)
+		}
+
+		key := cacheKey(req)
+		cached := c.cache.get(key)
+		if cached != nil && c.cache.now().Before(cached.expires) {
+			stateOf(ctx).cached = true
+			return cached.response(req), nil
+		}
+
+		if cached != nil && cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusNotModified && cached != nil:
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+
+			e := *cached
+			e.expires = c.cache.now().Add(ttl)
+			c.cache.put(key, &e)
+
+			stateOf(ctx).cached = true
+			return e.response(req), nil
+
+		case resp.StatusCode == http.StatusOK:
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			c.cache.put(key, &cacheEntry{
+				service: service,
+				expires: c.cache.now().Add(ttl),
+				etag:    resp.Header.Get("ETag"),
+				status:  resp.StatusCode,
+				header:  resp.Header.Clone(),
+				body:    body,
+			})
+		}
+
+		return resp, nil
+	}
+}
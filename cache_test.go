@@ -0,0 +1,66 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_cache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	now := time.Now()
+	require.NoError(t, SetCache(CacheOptions{
+		TTLs: map[CachedService]time.Duration{CachedImageTemplates: time.Minute},
+	})(client))
+	client.cache.now = func() time.Time { return now }
+
+	var lists, revalidations int
+	mux.HandleFunc("/templates.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"image_template": {"id": 2}}`)
+			return
+		}
+
+		lists++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `[{"image_template": {"id": 1, "label": "Debian"}}]`)
+	})
+
+	list := func() *Response {
+		templates, resp, err := client.ImageTemplates.List(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+		require.Equal(t, "Debian", templates[0].Label)
+		return resp
+	}
+
+	require.False(t, list().Cached)
+	require.True(t, list().Cached)
+	require.Equal(t, 1, lists)
+
+	// expired, revalidated with the ETag
+	now = now.Add(2 * time.Minute)
+	require.True(t, list().Cached)
+	require.Equal(t, 2, lists)
+	require.Equal(t, 1, revalidations)
+
+	// invalidated by a mutation of the same service
+	_, _, err := client.ImageTemplates.Create(ctx, &ImageTemplateCreateRequest{ManagerID: "ubuntu-22.04"})
+	require.NoError(t, err)
+	require.False(t, list().Cached)
+	require.Equal(t, 3, lists)
+
+	client.InvalidateCache(CachedImageTemplates)
+	require.False(t, list().Cached)
+	require.Equal(t, 4, lists)
+}
@@ -0,0 +1,206 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	version "github.com/hashicorp/go-version"
+)
+
+// ErrUnsupportedByServer is matched with errors.Is by the errors of the
+// methods called against a Control Panel which doesn't support them.
+var ErrUnsupportedByServer = errors.New("onapp: unsupported by server")
+
+// Feature is a part of the OnApp API which is not available in every
+// version of the Control Panel.
+type Feature string
+
+// Features checked by the services before sending their requests. When the
+// version of the Control Panel can't be found the requests are sent anyway,
+// so the error of the Control Panel is returned instead, and the failure is
+// cached for a minute.
+const (
+	// FeatureBuckets is the BucketsService, which replaced the billing plans
+	FeatureBuckets Feature = "buckets"
+
+	// FeatureAccessControls is the AccessControlsService of the buckets
+	FeatureAccessControls Feature = "access_controls"
+
+	// FeatureRateCards is the RateCardsService of the buckets
+	FeatureRateCards Feature = "rate_cards"
+)
+
+// features maps the features to the versions of the Control Panel
+// supporting them.
+var features = map[Feature]version.Constraints{
+	FeatureBuckets:        version.MustConstraints(version.NewConstraint(">= 6.0")),
+	FeatureAccessControls: version.MustConstraints(version.NewConstraint(">= 6.0")),
+	FeatureRateCards:      version.MustConstraints(version.NewConstraint(">= 6.0")),
+}
+
+// UnsupportedError reports a feature not supported by the version of the
+// Control Panel.
+type UnsupportedError struct {
+	Feature Feature
+
+	// Versions supporting the feature
+	Required version.Constraints
+
+	// Version of the Control Panel
+	ServerVersion *version.Version
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("onapp: %s requires OnApp %s, the server runs %s", e.Feature, e.Required, e.ServerVersion)
+}
+
+// Is makes errors.Is match ErrUnsupportedByServer.
+func (e *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupportedByServer
+}
+
+// serverVersionFailureTTL is the time the failure to find the version of
+// the Control Panel is cached.
+const serverVersionFailureTTL = time.Minute
+
+// serverVersion caches the version of the Control Panel.
+type serverVersion struct {
+	mu sync.Mutex
+	v  *version.Version
+
+	// err of the last probe, cached until failedUntil
+	err         error
+	failedUntil time.Time
+
+	// probe is closed when the probe in flight is done, nil if there is none
+	probe chan struct{}
+}
+
+// SetServerVersion is a client option for setting the version of the Control
+// Panel instead of requesting it, for example "6.5.0-12".
+func SetServerVersion(v string) ClientOpt {
+	return func(c *Client) error {
+		parsed, err := parseServerVersion(v)
+		if err != nil {
+			return err
+		}
+
+		c.serverVersion.mu.Lock()
+		c.serverVersion.v = parsed
+		c.serverVersion.err = nil
+		c.serverVersion.mu.Unlock()
+		return nil
+	}
+}
+
+// parseServerVersion parses a version of OnApp, the build number following
+// the release ("6.5.0-12") is ignored.
+func parseServerVersion(s string) (*version.Version, error) {
+	v, err := version.NewVersion(s)
+	if err != nil {
+		return nil, fmt.Errorf("onapp: invalid server version %q: %w", s, err)
+	}
+
+	return v.Core(), nil
+}
+
+// ServerVersion returns the version of the Control Panel. It is requested
+// once and cached by the client, a failure is cached for a minute. Concurrent
+// calls wait for the same request.
+func (c *Client) ServerVersion(ctx context.Context) (*version.Version, error) {
+	sv := &c.serverVersion
+	for {
+		sv.mu.Lock()
+		v, err, probe := sv.v, sv.err, sv.probe
+		if err != nil && !time.Now().Before(sv.failedUntil) {
+			err = nil
+		}
+
+		if v != nil || err != nil {
+			sv.mu.Unlock()
+			return v, err
+		}
+
+		if probe == nil {
+			break
+		}
+		sv.mu.Unlock()
+
+		select {
+		case <-probe:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	probe := make(chan struct{})
+	sv.probe = probe
+	sv.mu.Unlock()
+
+	v, err := c.probeServerVersion(ctx)
+
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	sv.probe = nil
+	close(probe)
+
+	switch {
+	case err == nil:
+		sv.v = v
+	case ctx.Err() == nil:
+		// the end of ctx is the failure of the caller only
+		sv.err = err
+		sv.failedUntil = time.Now().Add(serverVersionFailureTTL)
+	}
+
+	return v, err
+}
+
+// probeServerVersion requests the version of the Control Panel.
+func (c *Client) probeServerVersion(ctx context.Context) (*version.Version, error) {
+	s, _, err := c.version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseServerVersion(s)
+}
+
+// Supports reports whether the Control Panel supports the feature. Unknown
+// features are supported.
+func (c *Client) Supports(ctx context.Context, feature Feature) (bool, error) {
+	required, ok := features[feature]
+	if !ok {
+		return true, nil
+	}
+
+	v, err := c.ServerVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return required.Check(v), nil
+}
+
+// requireFeature returns an *UnsupportedError if the Control Panel doesn't
+// support the feature. The request is let through when the version can't be
+// found, so the error of the Control Panel is returned instead, see the
+// features.
+func (c *Client) requireFeature(ctx context.Context, feature Feature) error {
+	ok, err := c.Supports(ctx, feature)
+	if err != nil {
+		c.logger.Warn("onapp server version unknown", "feature", string(feature), "error", err.Error())
+		return nil
+	}
+
+	if !ok {
+		v, _ := c.ServerVersion(ctx)
+		return &UnsupportedError{Feature: feature, Required: features[feature], ServerVersion: v}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ServerVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	probes := 0
+	mux.HandleFunc("/version.json", func(w http.ResponseWriter, r *http.Request) {
+		probes++
+		fmt.Fprint(w, `{"version": "5.10.0-42"}`)
+	})
+	mux.HandleFunc("/billing/buckets.json", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent to an unsupported endpoint")
+	})
+
+	v, err := client.ServerVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "5.10.0", v.String())
+
+	ok, err := client.Supports(ctx, FeatureBuckets)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, _, err = client.Buckets.List(ctx, nil)
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	require.EqualError(t, err, "onapp: buckets requires OnApp >= 6.0, the server runs 5.10.0")
+	require.Equal(t, 1, probes)
+
+	require.NoError(t, SetServerVersion("6.5.0-12")(client))
+	ok, err = client.Supports(ctx, FeatureRateCards)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestClient_ServerVersion_failure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	probes := 0
+	mux.HandleFunc("/version.json", func(w http.ResponseWriter, r *http.Request) {
+		probes++
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusForbidden)
+	})
+	buckets := 0
+	mux.HandleFunc("/billing/buckets.json", func(w http.ResponseWriter, r *http.Request) {
+		buckets++
+		fmt.Fprint(w, `[]`)
+	})
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.ServerVersion(ctx)
+		errs <- err
+	}()
+	<-started
+
+	// the other callers are not blocked by the probe in flight
+	short, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err := client.ServerVersion(short)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	require.Error(t, <-errs)
+
+	// the failure is cached and the requests are let through
+	_, _, err = client.Buckets.List(ctx, nil)
+	require.NoError(t, err)
+	_, _, err = client.Buckets.List(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, probes)
+	require.Equal(t, 2, buckets)
+}
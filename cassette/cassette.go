@@ -0,0 +1,313 @@
+// Package cassette records the requests made to the OnApp API into fixture
+// files and replays them, so tests run without a Control Panel.
+//
+// Record once against a real Control Panel:
+//
+//	rec := cassette.NewRecorder("testdata/vm_startup.json")
+//	client, _ := onappgo.New(httpClient, cassette.Record(rec))
+//	...
+//	err := rec.Save()
+//
+// Then replay the fixture in the tests:
+//
+//	rep, err := cassette.Load("testdata/vm_startup.json")
+//	client, _ := onappgo.New(nil, cassette.Replay(rep))
+//
+// The Authorization and cookie headers are never recorded, the password
+// fields of the JSON bodies are scrubbed.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+// scrubbedHeaders are never written to the fixture files.
+var scrubbedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// DefaultIgnoredParams are the query parameters which differ between runs,
+// so they aren't used to match the requests when replaying.
+var DefaultIgnoredParams = []string{"since"}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a request and the response to it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the content of a fixture file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is a http.RoundTripper saving the requests made through it and
+// the responses to them.
+type Recorder struct {
+	path string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a Recorder saving to the file at path.
+func NewRecorder(path string) *Recorder {
+	return &Recorder{
+		path: path,
+		next: http.DefaultTransport,
+	}
+}
+
+// Record is a client option for recording the requests of the client with r.
+// It must come after the options changing the transport of the client.
+func Record(r *Recorder) onappgo.ClientOpt {
+	return onappgo.WrapTransport(func(next http.RoundTripper) http.RoundTripper {
+		r.next = next
+		return r
+	})
+}
+
+// RoundTrip sends the request with the wrapped transport and records it.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the request, its body is replaced on a
+	// copy
+	req = req.Clone(req.Context())
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	i := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: scrubHeader(req.Header),
+			Body:   scrubBody(reqBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+			Body:       scrubBody(respBody),
+		},
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, i)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Save writes the recorded interactions to the file of the Recorder.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// Replayer is a http.RoundTripper answering the requests with the responses
+// of a fixture file. Every interaction is used once, in the recorded order
+// for identical requests. Unmatched requests fail.
+type Replayer struct {
+	// IgnoredParams are the query parameters not used to match the requests,
+	// DefaultIgnoredParams by default.
+	IgnoredParams []string
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// Load returns a Replayer of the fixture file at path.
+func Load(path string) (*Replayer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("cassette: %s: %w", path, err)
+	}
+
+	return &Replayer{
+		IgnoredParams: DefaultIgnoredParams,
+		cassette:      c,
+		used:          make([]bool, len(c.Interactions)),
+	}, nil
+}
+
+// Replay is a client option for answering the requests of the client with r
+// instead of sending them.
+func Replay(r *Replayer) onappgo.ClientOpt {
+	return onappgo.WrapTransport(func(http.RoundTripper) http.RoundTripper {
+		return r
+	})
+}
+
+// RoundTrip returns the response recorded for the request.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	key := r.requestKey(req.Method, req.URL, scrubBody(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] {
+			continue
+		}
+
+		u, err := url.Parse(interaction.Request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: %w", err)
+		}
+
+		if r.requestKey(interaction.Request.Method, u, interaction.Request.Body) != key {
+			continue
+		}
+
+		r.used[i] = true
+		return newResponse(req, interaction.Response), nil
+	}
+
+	return nil, fmt.Errorf("cassette: unmatched request %s %s", req.Method, req.URL)
+}
+
+// Unused returns the interactions which weren't replayed yet.
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
+
+// requestKey identifies a request by its method, path, query and body.
+func (r *Replayer) requestKey(method string, u *url.URL, body string) string {
+	query := u.Query()
+	for _, param := range r.IgnoredParams {
+		query.Del(param)
+	}
+
+	return strings.Join([]string{method, u.Path, query.Encode(), body}, "\n")
+}
+
+func newResponse(req *http.Request, r Response) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// readBody reads the body and replaces it by a copy, so it can still be read
+// by the caller.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+func scrubHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range scrubbedHeaders {
+		h.Del(name)
+	}
+
+	return h
+}
+
+// scrubBody redacts the password fields of JSON bodies, other bodies are
+// kept as they are.
+func scrubBody(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	if scrubbed := onappgo.RedactJSON(data); scrubbed != nil {
+		return string(scrubbed)
+	}
+
+	return string(data)
+}
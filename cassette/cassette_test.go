@@ -0,0 +1,95 @@
+package cassette
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+func newClient(t *testing.T, baseURL string, opts ...onappgo.ClientOpt) *onappgo.Client {
+	opts = append([]onappgo.ClientOpt{onappgo.SetBasicAuth("admin@example.com", "secret-token")}, opts...)
+	client, err := onappgo.New(nil, opts...)
+	require.NoError(t, err)
+
+	client.BaseURL, err = url.Parse(baseURL)
+	require.NoError(t, err)
+	return client
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "users.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/users.json":
+			fmt.Fprint(w, `{"user": {"id": 7, "login": "jdoe"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/users/7.json":
+			fmt.Fprint(w, `{"user": {"id": 7, "login": "jdoe", "api_key": "abcdef"}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	rec := NewRecorder(path)
+	client := newClient(t, server.URL, Record(rec))
+
+	createRequest := &onappgo.UserCreateRequest{Login: "jdoe", Password: "P@ssw0rd"}
+	_, _, err := client.Users.Create(ctx, createRequest)
+	require.NoError(t, err)
+	_, _, err = client.Users.Get(ctx, 7)
+	require.NoError(t, err)
+	require.NoError(t, rec.Save())
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"P@ssw0rd", "abcdef", "Basic "} {
+		require.False(t, strings.Contains(string(data), secret), "fixture contains %q", secret)
+	}
+
+	rep, err := Load(path)
+	require.NoError(t, err)
+	client = newClient(t, server.URL, Replay(rep))
+
+	user, _, err := client.Users.Create(ctx, createRequest)
+	require.NoError(t, err)
+	require.Equal(t, 7, user.ID)
+
+	user, _, err = client.Users.Get(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, "jdoe", user.Login)
+	require.Empty(t, rep.Unused())
+
+	_, _, err = client.Users.Get(ctx, 7)
+	require.ErrorContains(t, err, "unmatched request GET")
+}
+
+func TestRecorder_requestUnmodified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	rec := NewRecorder(filepath.Join(t.TempDir(), "users.json"))
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/users.json", strings.NewReader(`{"user": {}}`))
+	require.NoError(t, err)
+	body := req.Body
+
+	resp, err := rec.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Equal(t, body, req.Body)
+	require.JSONEq(t, `{"user": {}}`, rec.cassette.Interactions[0].Request.Body)
+}
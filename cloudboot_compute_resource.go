@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -173,7 +172,6 @@ func (s *CloudbootComputeResourcesServiceOp) Create(ctx context.Context, createR
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("CloudbootComputeResource [Create] req: ", req)
 
 	root := new(cloudbootComputeResourceRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -200,7 +198,6 @@ func (s *CloudbootComputeResourcesServiceOp) Delete(ctx context.Context, id int,
 	if err != nil {
 		return nil, err
 	}
-	log.Println("CloudbootComputeResource [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -217,7 +214,6 @@ func (s *CloudbootComputeResourcesServiceOp) Edit(ctx context.Context, id int, e
 	if err != nil {
 		return nil, err
 	}
-	log.Println("CloudbootComputeResource [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -125,7 +124,6 @@ func (s *CloudbootIPAddressesServiceOp) Create(ctx context.Context, createReques
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("CloudbootIPAddress [Create] req: ", req)
 
 	root := new(cloudbootIPAddressRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -152,7 +150,6 @@ func (s *CloudbootIPAddressesServiceOp) Delete(ctx context.Context, id int, meta
 	if err != nil {
 		return nil, err
 	}
-	log.Println("CloudbootIPAddress [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
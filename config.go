@@ -0,0 +1,354 @@
+package onappgo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by NewFromEnv and NewFromConfig, in addition to
+// EnvUser, EnvAPIKey and EnvToken. They override the values of the profile.
+const (
+	EnvConfig             = "ONAPP_CONFIG"
+	EnvContext            = "ONAPP_CONTEXT"
+	EnvURL                = "ONAPP_URL"
+	EnvCredentialsFile    = "ONAPP_CREDENTIALS_FILE"
+	EnvInsecureSkipVerify = "ONAPP_INSECURE_SKIP_VERIFY"
+	EnvCABundle           = "ONAPP_CA_BUNDLE"
+	EnvClientCertificate  = "ONAPP_CLIENT_CERTIFICATE"
+	EnvClientKey          = "ONAPP_CLIENT_KEY"
+	EnvTimeout            = "ONAPP_TIMEOUT"
+	EnvUserAgent          = "ONAPP_USER_AGENT"
+)
+
+// Config is a configuration file with a profile for every Control Panel,
+// like a kubeconfig:
+//
+//	current-context: production
+//	contexts:
+//	  production:
+//	    url: https://cp.example.com
+//	    user: admin@example.com
+//	    api_key: 0123456789abcdef
+//	    ca_bundle: /etc/onapp/ca.pem
+//	    timeout: 30s
+//	  staging:
+//	    url: https://cp.staging.example.com
+//	    credentials_file: ~/.onapp/staging.json
+type Config struct {
+	// Name of the profile used when none is given
+	CurrentContext string `yaml:"current-context,omitempty"`
+
+	Contexts map[string]*Profile `yaml:"contexts"`
+}
+
+// Profile holds the settings of the Client of a Control Panel.
+type Profile struct {
+	// Base URL of the Control Panel
+	URL string `yaml:"url"`
+
+	// Credentials, or the path of a file read by FileCredentials
+	User            string `yaml:"user,omitempty"`
+	APIKey          string `yaml:"api_key,omitempty"`
+	Token           string `yaml:"token,omitempty"`
+	CredentialsFile string `yaml:"credentials_file,omitempty"`
+
+	// TLS settings, see SetAllowUnverifiedSSL, SetCABundle,
+	// SetClientCertificateFile and SetPinnedCertificates
+	InsecureSkipVerify bool     `yaml:"insecure_skip_verify,omitempty"`
+	CABundle           string   `yaml:"ca_bundle,omitempty"`
+	ClientCertificate  string   `yaml:"client_certificate,omitempty"`
+	ClientKey          string   `yaml:"client_key,omitempty"`
+	PinnedCertificates []string `yaml:"pinned_certificates,omitempty"`
+
+	// Time limit of every HTTP request, none by default
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+
+	// Prefix of the user agent of the requests
+	UserAgent string `yaml:"user_agent,omitempty"`
+}
+
+// ConfigError reports the invalid settings of a profile.
+type ConfigError struct {
+	// Name of the profile, empty if it was built from the environment only
+	Context string
+
+	Problems []string
+}
+
+func (e *ConfigError) Error() string {
+	name := "environment"
+	if e.Context != "" {
+		name = fmt.Sprintf("context %q", e.Context)
+	}
+
+	return fmt.Sprintf("onapp config: %s: %s", name, strings.Join(e.Problems, "; "))
+}
+
+// DefaultConfigPath returns the path of the configuration file read by
+// NewFromEnv when EnvConfig is not set, ~/.onapp/config.yaml.
+func DefaultConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".onapp", "config.yaml")
+}
+
+// LoadConfig reads a YAML configuration file. JSON files are accepted too.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("onapp config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("onapp config: %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// Profile returns a copy of the profile named name, or of the current
+// context if name is empty. A file with a single profile doesn't need a
+// current context.
+func (cfg *Config) Profile(name string) (*Profile, error) {
+	_, p, err := cfg.profile(name)
+	return p, err
+}
+
+// profile returns the name and a copy of the profile.
+func (cfg *Config) profile(name string) (string, *Profile, error) {
+	names := make([]string, 0, len(cfg.Contexts))
+	for n := range cfg.Contexts {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	if name == "" {
+		if len(names) != 1 {
+			return "", nil, errors.New("onapp config: no context given and current-context is not set")
+		}
+		name = names[0]
+	}
+
+	p := cfg.Contexts[name]
+	if p == nil {
+		return "", nil, fmt.Errorf("onapp config: context %q not found, known contexts: %s", name, strings.Join(names, ", "))
+	}
+
+	cp := *p
+	cp.PinnedCertificates = append([]string(nil), p.PinnedCertificates...)
+	return name, &cp, nil
+}
+
+// ApplyEnv overrides the settings of the profile with the ONAPP_*
+// environment variables which are set. Credentials set by the environment
+// replace the other kinds of credentials of the profile: a token replaces
+// the user and API key, and the other way round.
+func (p *Profile) ApplyEnv() error {
+	set := func(name string) bool {
+		_, ok := os.LookupEnv(name)
+		return ok
+	}
+
+	switch {
+	case set(EnvToken):
+		p.User, p.APIKey, p.CredentialsFile = "", "", ""
+	case set(EnvUser) || set(EnvAPIKey):
+		p.Token, p.CredentialsFile = "", ""
+	case set(EnvCredentialsFile):
+		p.User, p.APIKey, p.Token = "", "", ""
+	}
+
+	strs := map[string]*string{
+		EnvURL:               &p.URL,
+		EnvUser:              &p.User,
+		EnvAPIKey:            &p.APIKey,
+		EnvToken:             &p.Token,
+		EnvCredentialsFile:   &p.CredentialsFile,
+		EnvCABundle:          &p.CABundle,
+		EnvClientCertificate: &p.ClientCertificate,
+		EnvClientKey:         &p.ClientKey,
+		EnvUserAgent:         &p.UserAgent,
+	}
+	for name, s := range strs {
+		if v, ok := os.LookupEnv(name); ok {
+			*s = v
+		}
+	}
+
+	if v, ok := os.LookupEnv(EnvInsecureSkipVerify); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("onapp config: %s: %q is not a boolean", EnvInsecureSkipVerify, v)
+		}
+		p.InsecureSkipVerify = b
+	}
+
+	if v, ok := os.LookupEnv(EnvTimeout); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("onapp config: %s: %q is not a duration", EnvTimeout, v)
+		}
+		p.Timeout = d
+	}
+
+	return nil
+}
+
+// Validate checks the settings of the profile. The files it refers to are
+// read when the Client is created.
+func (p *Profile) Validate() error {
+	var problems []string
+
+	if p.URL == "" {
+		problems = append(problems, "url is required")
+	} else if u, err := url.Parse(p.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		problems = append(problems, fmt.Sprintf("url %q is not an absolute http or https URL", p.URL))
+	}
+
+	if p.Token != "" && (p.User != "" || p.APIKey != "") {
+		problems = append(problems, "token cannot be used with user and api_key")
+	}
+	if p.CredentialsFile != "" && (p.Token != "" || p.User != "" || p.APIKey != "") {
+		problems = append(problems, "credentials_file cannot be used with user, api_key or token")
+	}
+	if (p.User == "") != (p.APIKey == "") {
+		problems = append(problems, "user and api_key must be set together")
+	}
+
+	if (p.ClientCertificate == "") != (p.ClientKey == "") {
+		problems = append(problems, "client_certificate and client_key must be set together")
+	}
+
+	if p.Timeout < 0 {
+		problems = append(problems, "timeout cannot be negative")
+	}
+
+	if len(problems) > 0 {
+		return &ConfigError{Problems: problems}
+	}
+
+	return nil
+}
+
+// ClientOpts returns the client options configuring a Client with the
+// settings of the profile.
+func (p *Profile) ClientOpts() []ClientOpt {
+	opts := []ClientOpt{SetBaseURL(p.URL)}
+
+	switch {
+	case p.CredentialsFile != "":
+		opts = append(opts, SetCredentialsProvider(&FileCredentials{Path: expandHome(p.CredentialsFile)}))
+	case p.User != "" || p.APIKey != "" || p.Token != "":
+		opts = append(opts, SetCredentialsProvider(StaticCredentials(Credentials{User: p.User, APIKey: p.APIKey, Token: p.Token})))
+	}
+
+	if p.InsecureSkipVerify {
+		opts = append(opts, SetAllowUnverifiedSSL(true))
+	}
+	if p.CABundle != "" {
+		opts = append(opts, SetCABundle(expandHome(p.CABundle)))
+	}
+	if p.ClientCertificate != "" {
+		opts = append(opts, SetClientCertificateFile(expandHome(p.ClientCertificate), expandHome(p.ClientKey)))
+	}
+	if len(p.PinnedCertificates) > 0 {
+		opts = append(opts, SetPinnedCertificates(p.PinnedCertificates...))
+	}
+
+	if p.Timeout > 0 {
+		opts = append(opts, SetTimeout(p.Timeout))
+	}
+	if p.UserAgent != "" {
+		opts = append(opts, SetUserAgent(p.UserAgent))
+	}
+
+	return opts
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, path[1:])
+}
+
+// NewFromConfig returns a client configured with a profile of the
+// configuration file, the current context if name is empty. The ONAPP_*
+// environment variables override the settings of the profile, and opts are
+// applied last.
+func NewFromConfig(path, name string, opts ...ClientOpt) (*Client, error) {
+	cfg, err := LoadConfig(expandHome(path))
+	if err != nil {
+		return nil, err
+	}
+
+	name, p, err := cfg.profile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFromProfile(p, name, opts)
+}
+
+// NewFromEnv returns a client configured with the ONAPP_* environment
+// variables. The profile named by EnvContext of the file named by EnvConfig,
+// or DefaultConfigPath if it exists, is read first and overridden by the
+// other variables.
+func NewFromEnv(opts ...ClientOpt) (*Client, error) {
+	path, ok := os.LookupEnv(EnvConfig)
+	if !ok {
+		path = DefaultConfigPath()
+		if _, err := os.Stat(path); err != nil {
+			path = ""
+		}
+	}
+
+	if path == "" {
+		return newFromProfile(&Profile{}, "", opts)
+	}
+
+	return NewFromConfig(path, os.Getenv(EnvContext), opts...)
+}
+
+func newFromProfile(p *Profile, name string, opts []ClientOpt) (*Client, error) {
+	if err := p.ApplyEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := p.Validate(); err != nil {
+		var cfgErr *ConfigError
+		if errors.As(err, &cfgErr) {
+			cfgErr.Context = name
+		}
+		return nil, err
+	}
+
+	c, err := New(nil, append(p.ClientOpts(), opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("onapp config: %w", err)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,107 @@
+package onappgo
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+current-context: production
+contexts:
+  production:
+    url: https://cp.example.com
+    user: admin@example.com
+    api_key: production-key
+    timeout: 30s
+    user_agent: inventory/1.0
+  staging:
+    url: cp.staging.example.com
+    user: admin@example.com
+    client_key: /etc/onapp/client.key
+  tokens:
+    url: https://cp.example.com
+    token: profile-token
+`
+
+func TestNewFromConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfig), 0o600))
+
+	c, err := NewFromConfig(path, "")
+	require.NoError(t, err)
+	require.Equal(t, "https://cp.example.com", c.BaseURL.String())
+	require.Equal(t, 30*time.Second, c.client.Timeout)
+	require.Equal(t, "inventory/1.0 "+userAgent, c.UserAgent)
+
+	t.Setenv(EnvAPIKey, "env-key")
+	c, err = NewFromConfig(path, "production")
+	require.NoError(t, err)
+
+	req, err := c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+	require.NoError(t, err)
+	_, key, _ := req.BasicAuth()
+	require.Equal(t, "env-key", key)
+
+	// the API key of staging is set by the environment
+	_, err = NewFromConfig(path, "staging")
+	var cfgErr *ConfigError
+	require.True(t, errors.As(err, &cfgErr))
+	require.Equal(t, "staging", cfgErr.Context)
+	require.Equal(t, []string{
+		`url "cp.staging.example.com" is not an absolute http or https URL`,
+		"client_certificate and client_key must be set together",
+	}, cfgErr.Problems)
+
+	_, err = NewFromConfig(path, "development")
+	require.EqualError(t, err, `onapp config: context "development" not found, known contexts: production, staging, tokens`)
+}
+
+func TestNewFromConfig_envCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfig), 0o600))
+
+	// a token replaces the user and API key of the profile
+	t.Setenv(EnvToken, "env-token")
+	c, err := NewFromConfig(path, "production")
+	require.NoError(t, err)
+
+	req, err := c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer env-token", req.Header.Get("Authorization"))
+
+	// a user and API key replace the token of the profile
+	os.Unsetenv(EnvToken)
+	t.Setenv(EnvUser, "env@example.com")
+	t.Setenv(EnvAPIKey, "env-key")
+	c, err = NewFromConfig(path, "tokens")
+	require.NoError(t, err)
+
+	req, err = c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+	require.NoError(t, err)
+	user, key, ok := req.BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "env@example.com", user)
+	require.Equal(t, "env-key", key)
+}
+
+func TestNewFromEnv(t *testing.T) {
+	t.Setenv(EnvConfig, "")
+	t.Setenv(EnvURL, "https://cp.example.com")
+	t.Setenv(EnvToken, "abc")
+	t.Setenv(EnvTimeout, "1m")
+
+	c, err := NewFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, "https://cp.example.com", c.BaseURL.String())
+	require.Equal(t, time.Minute, c.client.Timeout)
+
+	t.Setenv(EnvURL, "")
+	_, err = NewFromEnv()
+	require.EqualError(t, err, "onapp config: environment: url is required")
+}
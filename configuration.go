@@ -2,7 +2,6 @@ package onappgo
 
 import (
 	"context"
-	"log"
 	"net/http"
 )
 
@@ -305,7 +304,6 @@ func (s *ConfigurationsServiceOp) Edit(ctx context.Context, editRequest *map[str
 	if err != nil {
 		return nil, err
 	}
-	log.Println("[DEBUG] Configuration [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
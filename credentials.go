@@ -0,0 +1,226 @@
+package onappgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// Environment variables read by EnvCredentials by default
+const (
+	EnvUser   = "ONAPP_USER"
+	EnvAPIKey = "ONAPP_API_KEY"
+	EnvToken  = "ONAPP_TOKEN"
+)
+
+// Credentials authenticate the requests made to the OnApp API.
+type Credentials struct {
+	// Email or login of the user, sent with the API key using Basic Auth
+	User string `json:"user,omitempty"`
+
+	// API key of the user, see UsersService.MakeNewAPIKey
+	APIKey string `json:"api_key,omitempty"`
+
+	// Token sent as a bearer token instead of the Basic Auth credentials
+	Token string `json:"token,omitempty"`
+}
+
+// authorize sets the Authorization header of the request, it is removed
+// when the credentials are empty.
+func (cr Credentials) authorize(req *http.Request) {
+	req.Header.Del("Authorization")
+
+	switch {
+	case cr.Token != "":
+		req.Header.Set("Authorization", "Bearer "+cr.Token)
+	case cr.User != "" || cr.APIKey != "":
+		req.SetBasicAuth(cr.User, cr.APIKey)
+	}
+}
+
+// CredentialsProvider supplies the credentials of every request made by the
+// Client. It must be safe for concurrent use.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsRefresher is implemented by the providers which cache the
+// credentials. Refresh is called when the OnApp API rejected a request with
+// 401 Unauthorized, the request is then sent again if the credentials
+// changed.
+type CredentialsRefresher interface {
+	Refresh(ctx context.Context) error
+}
+
+// CredentialsFunc adapts a function to the CredentialsProvider interface,
+// for example to read the credentials from a secret manager.
+type CredentialsFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials calls f.
+func (f CredentialsFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a provider of fixed credentials.
+func StaticCredentials(cr Credentials) CredentialsProvider {
+	return CredentialsFunc(func(context.Context) (Credentials, error) {
+		return cr, nil
+	})
+}
+
+// EnvCredentials reads the credentials from environment variables for every
+// request, so they can be changed without creating a new Client.
+type EnvCredentials struct {
+	// Names of the variables, EnvUser, EnvAPIKey and EnvToken by default
+	UserVar   string
+	APIKeyVar string
+	TokenVar  string
+}
+
+// Credentials returns the current values of the variables.
+func (e *EnvCredentials) Credentials(context.Context) (Credentials, error) {
+	return Credentials{
+		User:   os.Getenv(orDefault(e.UserVar, EnvUser)),
+		APIKey: os.Getenv(orDefault(e.APIKeyVar, EnvAPIKey)),
+		Token:  os.Getenv(orDefault(e.TokenVar, EnvToken)),
+	}, nil
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+
+	return s
+}
+
+// FileCredentials reads the credentials from a JSON file with the "user",
+// "api_key" and "token" fields. The file is read again when it is modified,
+// so the keys can be rotated without restarting the program.
+type FileCredentials struct {
+	Path string
+
+	mu      sync.Mutex
+	cached  Credentials
+	modTime time.Time
+	size    int64
+}
+
+// Credentials returns the content of the file, read again if the file was
+// modified since the last call.
+func (f *FileCredentials) Credentials(context.Context) (Credentials, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fi, err := os.Stat(f.Path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("onapp credentials: %w", err)
+	}
+
+	if fi.ModTime().Equal(f.modTime) && fi.Size() == f.size {
+		return f.cached, nil
+	}
+
+	return f.load()
+}
+
+// Refresh reads the file again.
+func (f *FileCredentials) Refresh(context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, err := f.load()
+	return err
+}
+
+func (f *FileCredentials) load() (Credentials, error) {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("onapp credentials: %w", err)
+	}
+	defer file.Close()
+
+	fi, err := file.Stat()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("onapp credentials: %w", err)
+	}
+
+	var cr Credentials
+	if err := json.NewDecoder(file).Decode(&cr); err != nil {
+		return Credentials{}, fmt.Errorf("onapp credentials: %s: %w", f.Path, err)
+	}
+
+	f.cached, f.modTime, f.size = cr, fi.ModTime(), fi.Size()
+	return cr, nil
+}
+
+// SetCredentialsProvider is a client option for authenticating the requests
+// with the credentials supplied by p, instead of the fixed ones of
+// SetBasicAuth.
+func SetCredentialsProvider(p CredentialsProvider) ClientOpt {
+	return func(c *Client) error {
+		c.credentials = p
+		return nil
+	}
+}
+
+// authorize sets the credentials of the request. Nothing is sent when the
+// client has no credentials.
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	if c.credentials == nil {
+		return nil
+	}
+
+	cr, err := c.credentials.Credentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	cr.authorize(req)
+	return nil
+}
+
+// authenticationMiddleware sends the request again when it was rejected with
+// 401 Unauthorized and the refreshed credentials changed.
+func (c *Client) authenticationMiddleware(next RoundTripFunc) RoundTripFunc {
+	if c.credentials == nil {
+		return next
+	}
+
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		resp, err := next(ctx, req)
+		if err != nil || resp.StatusCode != http.StatusUnauthorized {
+			return resp, err
+		}
+
+		if r, ok := c.credentials.(CredentialsRefresher); ok {
+			if err := r.Refresh(ctx); err != nil {
+				c.logger.Warn("onapp credentials refresh failed", "error", err.Error())
+				return resp, nil
+			}
+		}
+
+		used := req.Header.Get("Authorization")
+		if err := c.authorize(ctx, req); err != nil || req.Header.Get("Authorization") == used {
+			return resp, nil
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req.Body = body
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		return next(ctx, req)
+	}
+}
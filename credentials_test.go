@@ -0,0 +1,72 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequest_credentials(t *testing.T) {
+	c := NewClient(nil)
+	req, err := c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+	require.NoError(t, err)
+	require.Empty(t, req.Header.Get("Authorization"))
+
+	require.NoError(t, SetCredentialsProvider(StaticCredentials(Credentials{Token: "abc"}))(c))
+	req, err = c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer abc", req.Header.Get("Authorization"))
+
+	t.Setenv(EnvUser, "env@example.com")
+	t.Setenv(EnvAPIKey, "env-key")
+	require.NoError(t, SetCredentialsProvider(&EnvCredentials{})(c))
+	req, err = c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+	require.NoError(t, err)
+
+	user, key, ok := req.BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "env@example.com", user)
+	require.Equal(t, "env-key", key)
+}
+
+func TestDo_refreshCredentials(t *testing.T) {
+	setup()
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	writeCredentials := func(key string) {
+		data := fmt.Sprintf(`{"user": %q, "api_key": %q}`, email, key)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	}
+
+	writeCredentials("old-key")
+	provider := &FileCredentials{Path: path}
+	require.NoError(t, SetCredentialsProvider(provider)(client))
+
+	var keys []string
+	mux.HandleFunc("/version.json", func(w http.ResponseWriter, r *http.Request) {
+		_, key, _ := r.BasicAuth()
+		keys = append(keys, key)
+		if key != "new-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"version": "6.5"}`)
+	})
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "version.json", nil)
+	require.NoError(t, err)
+
+	// the key is rotated after the request was created
+	writeCredentials("new-key")
+
+	var out map[string]string
+	_, err = client.Do(ctx, req, &out)
+	require.NoError(t, err)
+	require.Equal(t, "6.5", out["version"])
+	require.Equal(t, []string{"old-key", "new-key"}, keys)
+}
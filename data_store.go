@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -193,7 +192,6 @@ func (s *DataStoresServiceOp) Create(ctx context.Context, createRequest *DataSto
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("DataStore [Create] req: ", req)
 
 	root := new(dataStoreRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -220,7 +218,6 @@ func (s *DataStoresServiceOp) Delete(ctx context.Context, id int, meta interface
 	if err != nil {
 		return nil, err
 	}
-	log.Println("DataStore [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -241,7 +238,6 @@ func (s *DataStoresServiceOp) Edit(ctx context.Context, id int, editRequest *Dat
 	if err != nil {
 		return nil, err
 	}
-	log.Println("DataStore [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -266,7 +262,6 @@ func (s *DataStoresServiceOp) IoLimits(ctx context.Context, id int, editRequest
 	if err != nil {
 		return nil, err
 	}
-	log.Println("DataStore [IoLimits]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -152,7 +151,6 @@ func (s *DataStoreGroupsServiceOp) Create(ctx context.Context, createRequest *Da
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("DataStoreGroup [Create] req: ", req)
 
 	root := new(dataStoreGroupRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -179,7 +177,6 @@ func (s *DataStoreGroupsServiceOp) Delete(ctx context.Context, id int, meta inte
 	if err != nil {
 		return nil, err
 	}
-	log.Println("DataStoreGroup [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -200,7 +197,6 @@ func (s *DataStoreGroupsServiceOp) Edit(ctx context.Context, id int, editRequest
 	if err != nil {
 		return nil, err
 	}
-	log.Println("DataStoreGroup [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -217,7 +213,6 @@ func (s *DataStoreGroupsServiceOp) Attach(ctx context.Context, resID int, id int
 	if err != nil {
 		return nil, err
 	}
-	log.Println("DataStoreGroup [Attach]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -234,7 +229,6 @@ func (s *DataStoreGroupsServiceOp) Detach(ctx context.Context, resID int, id int
 	if err != nil {
 		return nil, err
 	}
-	log.Println("DataStoreGroup [Detach]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -247,7 +241,6 @@ func (s *DataStoreGroupsServiceOp) AttachedDataStores(ctx context.Context, resID
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("DataStoreGroup [AttachedDataStores]  req: ", req)
 
 	var out []map[string]DataStore
 	resp, err := s.client.Do(ctx, req, &out)
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -147,7 +146,6 @@ func (s *DataStoreJoinsServiceOp) Create(ctx context.Context, createRequest *Dat
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("DataStoreJoin [Create] req: ", req)
 
 	root := new(dataStoreJoinRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -185,7 +183,6 @@ func (s *DataStoreJoinsServiceOp) Delete(ctx context.Context, deleteRequest *Dat
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("DataStoreJoin [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
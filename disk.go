@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -177,7 +176,6 @@ func (s *DisksServiceOp) Create(ctx context.Context, createRequest *DiskCreateRe
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("Disk [Create]  req: ", req)
 
 	root := new(diskRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -205,22 +203,23 @@ func (s *DisksServiceOp) Delete(ctx context.Context, id int, meta interface{}) (
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("Disk [Delete]  req: ", req)
+
+	// the span of the request lasts until its transaction is found
+	ctx, end := s.client.traceOperation(ctx)
+	defer end()
 
 	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	filter := struct {
-		ParentID   int
-		ParentType string
-	}{
+	opt := &TransactionListOptions{
+		Action:     "destroy_disk",
 		ParentID:   id,
 		ParentType: "Disk",
 	}
 
-	return lastTransaction(ctx, s.client, filter)
+	return requestTransaction(ctx, s.client, resp, opt)
 }
 
 // Edit Disk.
@@ -235,7 +234,6 @@ func (s *DisksServiceOp) Edit(ctx context.Context, id int, editRequest *DiskEdit
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Disk [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
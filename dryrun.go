@@ -0,0 +1,178 @@
+package onappgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PlannedOperation is a request changing the Control Panel which was not
+// sent because of the dry-run mode.
+type PlannedOperation struct {
+	Method string `json:"method"`
+
+	// Path of the endpoint relative to the base URL, with the query
+	Path string `json:"path"`
+
+	// JSON body with the secrets redacted, empty if there is none
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// Plan records the operations planned by a client in dry-run mode. It is
+// safe for concurrent use.
+type Plan struct {
+	mu  sync.Mutex
+	ops []PlannedOperation
+}
+
+// Operations returns the planned operations, in the order they were made.
+func (p *Plan) Operations() []PlannedOperation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedOperation(nil), p.ops...)
+}
+
+// Reset forgets the planned operations.
+func (p *Plan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.ops = nil
+}
+
+// MarshalJSON encodes the plan as the array of its operations.
+func (p *Plan) MarshalJSON() ([]byte, error) {
+	ops := p.Operations()
+	if ops == nil {
+		ops = []PlannedOperation{}
+	}
+
+	return json.Marshal(ops)
+}
+
+// WriteTo writes the plan as indented JSON, for a review.
+func (p *Plan) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(append(data, '\n'))
+	return int64(n), err
+}
+
+// SetDryRun is a client option for recording the requests changing the
+// Control Panel in the plan instead of sending them:
+//
+//	plan := new(onappgo.Plan)
+//	client, err := onappgo.New(nil, onappgo.SetDryRun(plan))
+//	...
+//	plan.WriteTo(os.Stdout)
+//
+// The GET requests are sent. The POST, PUT, PATCH and DELETE requests get a
+// synthetic 200 OK response, with Response.DryRun set, which echoes their
+// body, so the objects returned by the Create methods hold the requested
+// attributes and a zero ID. The methods which find the transaction of an
+// action return a nil Transaction. A nil plan disables the dry-run mode.
+func SetDryRun(plan *Plan) ClientOpt {
+	return func(c *Client) error {
+		c.dryRun = plan
+		return nil
+	}
+}
+
+// plans reports whether the request is recorded instead of being sent.
+func (p *Plan) plans(req *http.Request) bool {
+	if p == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	return true
+}
+
+// dryRunMiddleware records the requests changing the Control Panel in the
+// plan of the dry-run mode instead of sending them.
+func (c *Client) dryRunMiddleware(next RoundTripFunc) RoundTripFunc {
+	if c.dryRun == nil {
+		return next
+	}
+
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		if !c.dryRun.plans(req) {
+			return next(ctx, req)
+		}
+
+		stateOf(ctx).dryRun = true
+		return c.plannedResponse(req)
+	}
+}
+
+// plannedResponse records the request in the dry-run plan and returns its
+// synthetic response.
+func (c *Client) plannedResponse(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+
+		body, err = io.ReadAll(rc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	path := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, c.BaseURL.Path), "/")
+	if req.URL.RawQuery != "" {
+		path += "?" + req.URL.RawQuery
+	}
+
+	op := PlannedOperation{Method: req.Method, Path: path}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if redacted := RedactJSON(body); redacted != nil {
+			op.Body = redacted
+		}
+	}
+
+	c.dryRun.mu.Lock()
+	c.dryRun.ops = append(c.dryRun.ops, op)
+	c.dryRun.mu.Unlock()
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		body = []byte("{}")
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", mediaType)
+	header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	if id := req.Header.Get(headerRequestID); id != "" {
+		header.Set(headerRequestID, id)
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package onappgo
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_dryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	plan := new(Plan)
+	require.NoError(t, SetDryRun(plan)(client))
+
+	mux.HandleFunc("/billing/buckets/1.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"bucket": {"id": 1, "label": "gold"}}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s sent in dry-run mode", r.Method, r.URL)
+	})
+	require.NoError(t, SetServerVersion("6.5")(client))
+
+	bucket, _, err := client.Buckets.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "gold", bucket.Label)
+
+	created, resp, err := client.Users.Create(ctx, &UserCreateRequest{Login: "alice", Password: "s3cret"})
+	require.NoError(t, err)
+	require.True(t, resp.DryRun)
+	require.Equal(t, "alice", created.Login)
+
+	trx, resp, err := client.VirtualMachineActions.Stop(ctx, 42)
+	require.NoError(t, err)
+	require.True(t, resp.DryRun)
+	require.Nil(t, trx)
+
+	var buf bytes.Buffer
+	_, err = plan.WriteTo(&buf)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"method": "POST", "path": "users.json", "body": {"user": {"login": "alice", "password": "[REDACTED]"}}},
+		{"method": "POST", "path": "virtual_machines/42/stop.json"}
+	]`, buf.String())
+}
@@ -2,7 +2,6 @@ package onappgo
 
 import (
 	"context"
-	"log"
 	"net/http"
 )
 
@@ -43,7 +42,6 @@ func (s *EnginesServiceOp) Status(ctx context.Context) (*Engine, *Response, erro
 		return nil, nil, err
 	}
 
-	log.Println("Engine [Status] req: ", req)
 
 	root := &Engine{}
 	resp, err := s.client.Do(ctx, req, root)
@@ -63,7 +61,6 @@ func (s *EnginesServiceOp) Start(ctx context.Context) (*Engine, *Response, error
 		return nil, nil, err
 	}
 
-	log.Println("Engine [Start] req: ", req)
 
 	root := &Engine{}
 	resp, err := s.client.Do(ctx, req, root)
@@ -83,7 +80,6 @@ func (s *EnginesServiceOp) Stop(ctx context.Context) (*Engine, *Response, error)
 		return nil, nil, err
 	}
 
-	log.Println("Engine [Stop] req: ", req)
 
 	root := &Engine{}
 	resp, err := s.client.Do(ctx, req, root)
@@ -103,7 +99,6 @@ func (s *EnginesServiceOp) Reload(ctx context.Context) (*Engine, *Response, erro
 		return nil, nil, err
 	}
 
-	log.Println("Engine [Reload] req: ", req)
 
 	root := &Engine{}
 	resp, err := s.client.Do(ctx, req, root)
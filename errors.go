@@ -0,0 +1,213 @@
+package onappgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxErrorMessage is the length plain text error bodies are truncated to.
+const maxErrorMessage = 512
+
+// ErrorKind classifies the errors returned by the OnApp API.
+type ErrorKind int
+
+const (
+	// ErrorKindUnknown is an error which doesn't fit any other kind
+	ErrorKindUnknown ErrorKind = iota
+
+	// ErrorKindNotFound is a missing resource
+	ErrorKindNotFound
+
+	// ErrorKindUnauthorized is a missing or wrong credential
+	ErrorKindUnauthorized
+
+	// ErrorKindForbidden is an action not permitted to the user
+	ErrorKindForbidden
+
+	// ErrorKindValidation is a request rejected because of its parameters
+	ErrorKindValidation
+
+	// ErrorKindConflict is a request conflicting with the resource state,
+	// for example a locked VirtualMachine
+	ErrorKindConflict
+
+	// ErrorKindRateLimited is a request rejected by a rate limit
+	ErrorKindRateLimited
+
+	// ErrorKindServer is a failure of the OnApp server
+	ErrorKindServer
+)
+
+// Sentinel errors matching the ErrorResponse of the same kind with errors.Is.
+var (
+	ErrNotFound     = errors.New("onapp: not found")
+	ErrUnauthorized = errors.New("onapp: unauthorized")
+	ErrForbidden    = errors.New("onapp: forbidden")
+	ErrValidation   = errors.New("onapp: validation failed")
+	ErrConflict     = errors.New("onapp: conflict")
+	ErrRateLimited  = errors.New("onapp: rate limited")
+	ErrServer       = errors.New("onapp: server error")
+)
+
+var errorKinds = map[ErrorKind]error{
+	ErrorKindNotFound:     ErrNotFound,
+	ErrorKindUnauthorized: ErrUnauthorized,
+	ErrorKindForbidden:    ErrForbidden,
+	ErrorKindValidation:   ErrValidation,
+	ErrorKindConflict:     ErrConflict,
+	ErrorKindRateLimited:  ErrRateLimited,
+	ErrorKindServer:       ErrServer,
+}
+
+func (k ErrorKind) String() string {
+	if err, ok := errorKinds[k]; ok {
+		return strings.TrimPrefix(err.Error(), "onapp: ")
+	}
+
+	return "unknown"
+}
+
+// parse fills the error from the response body, which is either
+// {"errors": {"field": ["message"]}}, {"errors": ["message"]},
+// {"error": "message"} or plain text.
+func (r *ErrorResponse) parse(data []byte) {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(data, &body); err != nil {
+		msg := strings.TrimSpace(string(data))
+		if len(msg) > maxErrorMessage {
+			msg = msg[:maxErrorMessage] + "..."
+		}
+		r.Message = msg
+		return
+	}
+
+	if raw, ok := body["errors"]; ok {
+		var fields map[string][]string
+		var list []string
+
+		if err := json.Unmarshal(raw, &fields); err == nil {
+			r.Errors = fields
+		} else if err := json.Unmarshal(raw, &list); err == nil && len(list) > 0 {
+			r.Errors = map[string][]string{"base": list}
+		}
+	}
+
+	if raw, ok := body["error"]; ok {
+		var msg string
+		if err := json.Unmarshal(raw, &msg); err == nil {
+			r.Message = msg
+		}
+	}
+}
+
+// Kind classifies the error by the status code and the messages of the
+// response.
+func (r *ErrorResponse) Kind() ErrorKind {
+	if r.Response == nil {
+		return ErrorKindUnknown
+	}
+
+	switch code := r.Response.StatusCode; {
+	case code == http.StatusNotFound:
+		return ErrorKindNotFound
+	case code == http.StatusUnauthorized:
+		return ErrorKindUnauthorized
+	case code == http.StatusForbidden:
+		return ErrorKindForbidden
+	case code == http.StatusConflict || code == http.StatusLocked:
+		return ErrorKindConflict
+	case code == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case code == http.StatusBadRequest || code == http.StatusUnprocessableEntity:
+		if r.Locked() {
+			return ErrorKindConflict
+		}
+		return ErrorKindValidation
+	case code >= http.StatusInternalServerError:
+		return ErrorKindServer
+	}
+
+	return ErrorKindUnknown
+}
+
+// Is makes errors.Is match the sentinel error of the kind of r.
+func (r *ErrorResponse) Is(target error) bool {
+	err, ok := errorKinds[r.Kind()]
+	return ok && err == target
+}
+
+// Locked check if the error is caused by a locked resource.
+func (r *ErrorResponse) Locked() bool {
+	if r.Response != nil && r.Response.StatusCode == http.StatusLocked {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(r.String()), "locked")
+}
+
+// FieldErrors returns the validation messages by field name. Messages not
+// related to a field are under the "base" key.
+func (r *ErrorResponse) FieldErrors() map[string][]string {
+	return r.Errors
+}
+
+// RetryAfter returns the delay asked by the Retry-After header, if any.
+func (r *ErrorResponse) RetryAfter() time.Duration {
+	if r.Response == nil {
+		return 0
+	}
+
+	d, _ := retryAfter(r.Response.Header.Get("Retry-After"))
+	return d
+}
+
+// AsErrorResponse returns the *ErrorResponse wrapped by err, if any.
+func AsErrorResponse(err error) (*ErrorResponse, bool) {
+	var errorResponse *ErrorResponse
+	ok := errors.As(err, &errorResponse)
+	return errorResponse, ok
+}
+
+// IsNotFound check if err is caused by a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized check if err is caused by a missing or wrong credential.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden check if err is caused by an action not permitted to the user.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsValidation check if err is caused by wrong request parameters.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsConflict check if err is caused by the state of the resource.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsLocked check if err is caused by a locked resource.
+func IsLocked(err error) bool {
+	errorResponse, ok := AsErrorResponse(err)
+	return ok && errorResponse.Kind() == ErrorKindConflict && errorResponse.Locked()
+}
+
+// IsRateLimited check if err is caused by a rate limit.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsServerError check if err is caused by a failure of the OnApp server.
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServer)
+}
@@ -0,0 +1,87 @@
+package onappgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckResponse_kinds(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		body    string
+		kind    ErrorKind
+		is      func(error) bool
+		message string
+		fields  map[string][]string
+	}{
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			body:   `{"errors":["VirtualServer not found"]}`,
+			kind:   ErrorKindNotFound,
+			is:     IsNotFound,
+			fields: map[string][]string{"base": {"VirtualServer not found"}},
+		},
+		{
+			name:   "validation",
+			status: http.StatusUnprocessableEntity,
+			body:   `{"errors":{"label":["can't be blank"]}}`,
+			kind:   ErrorKindValidation,
+			is:     IsValidation,
+			fields: map[string][]string{"label": {"can't be blank"}},
+		},
+		{
+			name:   "locked",
+			status: http.StatusUnprocessableEntity,
+			body:   `{"errors":{"base":["Virtual server is locked"]}}`,
+			kind:   ErrorKindConflict,
+			is:     IsLocked,
+			fields: map[string][]string{"base": {"Virtual server is locked"}},
+		},
+		{
+			name:    "unauthorized",
+			status:  http.StatusUnauthorized,
+			body:    `{"error":"You need to sign in or sign up before continuing."}`,
+			kind:    ErrorKindUnauthorized,
+			is:      IsUnauthorized,
+			message: "You need to sign in or sign up before continuing.",
+		},
+		{
+			name:    "server error",
+			status:  http.StatusBadGateway,
+			body:    "<html>502 Bad Gateway</html>\n",
+			kind:    ErrorKindServer,
+			is:      IsServerError,
+			message: "<html>502 Bad Gateway</html>",
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			kind:   ErrorKindRateLimited,
+			is:     IsRateLimited,
+		},
+	}
+
+	for _, c := range cases {
+		res := &http.Response{
+			Request:    &http.Request{},
+			StatusCode: c.status,
+			Body:       ioutil.NopCloser(strings.NewReader(c.body)),
+		}
+
+		err := fmt.Errorf("wrapped: %w", CheckResponse(res))
+
+		errorResponse, ok := AsErrorResponse(err)
+		require.True(t, ok, c.name)
+		require.Equal(t, c.kind, errorResponse.Kind(), c.name)
+		require.True(t, c.is(err), c.name)
+		require.Equal(t, c.message, errorResponse.Message, c.name)
+		require.Equal(t, c.fields, errorResponse.FieldErrors(), c.name)
+	}
+}
@@ -204,18 +204,19 @@ func (s *HypervisorZonesServiceOp) Delete(ctx context.Context, id int, meta inte
 		return nil, nil, err
 	}
 
+	// the span of the request lasts until its transaction is found
+	ctx, end := s.client.traceOperation(ctx)
+	defer end()
+
 	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	filter := struct {
-		AssociatedObjectID   int
-		AssociatedObjectType string
-	}{
+	opt := &TransactionListOptions{
 		AssociatedObjectID:   id,
 		AssociatedObjectType: "HypervisorZone",
 	}
 
-	return lastTransaction(ctx, s.client, filter)
+	return requestTransaction(ctx, s.client, resp, opt)
 }
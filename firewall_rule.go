@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -143,7 +142,6 @@ func (s *FirewallRulesServiceOp) Create(ctx context.Context, vmID int, createReq
 	if err != nil {
 		return nil, nil, err
 	}
-	fmt.Println("FirewallRule [Create] req: ", req)
 
 	root := new(firewallRuleRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -171,7 +169,6 @@ func (s *FirewallRulesServiceOp) Delete(ctx context.Context, vmID int, id int, m
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("FirewallRule [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -193,7 +190,6 @@ func (s *FirewallRulesServiceOp) Edit(ctx context.Context, vmID int, id int, edi
 	if err != nil {
 		return nil, err
 	}
-	log.Println("FirewallRule [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -193,7 +192,6 @@ func (s *HypervisorGroupsServiceOp) Create(ctx context.Context, createRequest *H
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("HypervisorGroup [Create] req: ", req)
 
 	root := new(hypervisorGroupRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -220,7 +218,6 @@ func (s *HypervisorGroupsServiceOp) Delete(ctx context.Context, id int, meta int
 	if err != nil {
 		return nil, err
 	}
-	log.Println("HypervisorGroup [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -241,7 +238,6 @@ func (s *HypervisorGroupsServiceOp) Edit(ctx context.Context, id int, editReques
 	if err != nil {
 		return nil, err
 	}
-	log.Println("HypervisorGroup [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -257,7 +253,6 @@ func (s *HypervisorGroupsServiceOp) ListOfAttachedComputeResources(ctx context.C
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("HypervisorGroup [ListOfAttachedComputeResources]  req: ", req)
 
 	var out []map[string]Hypervisor
 	resp, err := s.client.Do(ctx, req, &out)
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -117,7 +116,6 @@ func (s *ImageTemplatesServiceOp) List(ctx context.Context, opt *ListOptions) ([
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("ImageTemplate [List] req: ", req)
 
 	var out []map[string]ImageTemplate
 	resp, err := s.client.Do(ctx, req, &out)
@@ -145,7 +143,6 @@ func (s *ImageTemplatesServiceOp) Get(ctx context.Context, id int) (*ImageTempla
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("ImageTemplate [Get] req: ", req)
 
 	root := new(imageTemplatesRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -171,7 +168,6 @@ func (s *ImageTemplatesServiceOp) Create(ctx context.Context, createRequest *Ima
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("ImageTemplate [Create] req: ", req)
 
 	root := new(imageTemplatesRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -198,7 +194,6 @@ func (s *ImageTemplatesServiceOp) Delete(ctx context.Context, id int, meta inter
 	if err != nil {
 		return nil, err
 	}
-	log.Println("ImageTemplate [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -219,7 +214,6 @@ func (s *ImageTemplatesServiceOp) Edit(ctx context.Context, id int, editRequest
 	if err != nil {
 		return nil, err
 	}
-	log.Println("ImageTemplate [Edit] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
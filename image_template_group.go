@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -161,7 +160,6 @@ func (s *ImageTemplateGroupsServiceOp) Create(ctx context.Context, createRequest
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("ImageTemplateGroup [Create] req: ", req)
 
 	root := new(imageTemplateGroupsRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -188,7 +186,6 @@ func (s *ImageTemplateGroupsServiceOp) Delete(ctx context.Context, id int, meta
 	if err != nil {
 		return nil, err
 	}
-	log.Println("ImageTemplateGroup [Delete]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -209,7 +206,6 @@ func (s *ImageTemplateGroupsServiceOp) Edit(ctx context.Context, id int, editReq
 	if err != nil {
 		return nil, err
 	}
-	log.Println("ImageTemplateGroup [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -233,7 +229,6 @@ func (s *ImageTemplateGroupsServiceOp) Attach(ctx context.Context, groupID int,
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("ImageTemplateGroup [Attach] req: ", req)
 
 	root := new(imageTemplateGroupsRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -257,7 +252,6 @@ func (s *ImageTemplateGroupsServiceOp) Detach(ctx context.Context, groupID int,
 	if err != nil {
 		return nil, err
 	}
-	log.Println("ImageTemplateGroup [Detach] req: ", req)
 
 	root := new(imageTemplateGroupsRoot)
 	resp, err := s.client.Do(ctx, req, root)
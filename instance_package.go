@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -130,7 +129,6 @@ func (s *InstancePackagesServiceOp) Create(ctx context.Context, createRequest *I
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("InstancePackage [Create] req: ", req)
 
 	root := new(instancePackageRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -157,7 +155,6 @@ func (s *InstancePackagesServiceOp) Delete(ctx context.Context, id int, meta int
 	if err != nil {
 		return nil, err
 	}
-	log.Println("InstancePackage [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -178,7 +175,6 @@ func (s *InstancePackagesServiceOp) Edit(ctx context.Context, id int, editReques
 	if err != nil {
 		return nil, err
 	}
-	log.Println("InstancePackage [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
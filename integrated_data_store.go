@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"strconv"
 
@@ -113,7 +112,6 @@ func (s *IntegratedDataStoresServiceOp) List(ctx context.Context, resID int, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("IntegratedDataStores [List]  req: ", req)
 
 	var out []map[string]IntegratedDataStores
 	resp, err := s.client.Do(ctx, req, &out)
@@ -142,7 +140,6 @@ func (s *IntegratedDataStoresServiceOp) Get(ctx context.Context, resID int, id s
 		return nil, nil, err
 	}
 
-	log.Println("IntegratedDataStores [Get]  req: ", req)
 
 	root := new(integratedDataStoreRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -168,7 +165,6 @@ func (s *IntegratedDataStoresServiceOp) Create(ctx context.Context, resID int, c
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("IntegratedDataStores [Create] req: ", req)
 
 	root := new(integratedDataStoreRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -198,7 +194,6 @@ func (s *IntegratedDataStoresServiceOp) Delete(ctx context.Context, resID int, i
 	if err != nil {
 		return nil, err
 	}
-	log.Println("IntegratedDataStores [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -220,7 +215,6 @@ func (s *IntegratedDataStoresServiceOp) Edit(ctx context.Context, resID int, id
 	if err != nil {
 		return nil, err
 	}
-	log.Println("IntegratedDataStores [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -237,7 +231,6 @@ func (s *IntegratedDataStoresServiceOp) StorageNodes(ctx context.Context, hvgID
 		return nil, nil, err
 	}
 
-	log.Println("IntegratedDataStores [StorageNodes]  req: ", req)
 
 	root := &StorageNodes{}
 	resp, err := s.client.Do(ctx, req, root)
@@ -260,7 +253,6 @@ func (s *IntegratedDataStoresServiceOp) BackendNodes(ctx context.Context, hvgID
 		return nil, nil, err
 	}
 
-	log.Println("IntegratedDataStores [BackendNodes]  req: ", req)
 
 	root := &BackendNodes{}
 	resp, err := s.client.Do(ctx, req, root)
@@ -0,0 +1,336 @@
+// Command mockgen generates the mocks of the onappgomock package from the
+// *Service interfaces of the onappgo package.
+//
+//	go run ./internal/cmd/mockgen -src . -out onappgomock/mocks_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const pkgName = "onappgo"
+
+type method struct {
+	name    string
+	params  []string
+	results []string
+}
+
+type service struct {
+	name    string
+	methods []method
+}
+
+type field struct {
+	name  string
+	iface string
+}
+
+func main() {
+	src := flag.String("src", ".", "directory of the onappgo package")
+	out := flag.String("out", "mocks_gen.go", "generated file")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, *src, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pkg, ok := pkgs[pkgName]
+	if !ok {
+		log.Fatalf("package %s not found in %s", pkgName, *src)
+	}
+
+	g := &generator{fset: fset, imports: map[string]bool{}}
+	g.collect(pkg)
+
+	data, err := g.generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	fset     *token.FileSet
+	exported map[string]bool
+	imports  map[string]bool
+	services []service
+	fields   []field
+}
+
+// collect finds the *Service interfaces and the fields of the Client using
+// them.
+func (g *generator) collect(pkg *ast.Package) {
+	g.exported = map[string]bool{}
+	var ifaces []*ast.TypeSpec
+	var client *ast.StructType
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if ts.Name.IsExported() {
+					g.exported[ts.Name.Name] = true
+				}
+
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					if strings.HasSuffix(ts.Name.Name, "Service") && ts.Name.IsExported() {
+						ifaces = append(ifaces, ts)
+					}
+				case *ast.StructType:
+					if ts.Name.Name == "Client" {
+						client = t
+					}
+				}
+			}
+		}
+	}
+
+	for _, ts := range ifaces {
+		g.services = append(g.services, g.service(ts))
+	}
+	sort.Slice(g.services, func(i, j int) bool { return g.services[i].name < g.services[j].name })
+
+	if client == nil {
+		log.Fatal("Client not found")
+	}
+
+	for _, f := range client.Fields.List {
+		ident, ok := f.Type.(*ast.Ident)
+		if !ok || !strings.HasSuffix(ident.Name, "Service") {
+			continue
+		}
+
+		for _, name := range f.Names {
+			g.fields = append(g.fields, field{name: name.Name, iface: ident.Name})
+		}
+	}
+}
+
+func (g *generator) service(ts *ast.TypeSpec) service {
+	s := service{name: ts.Name.Name}
+
+	for _, m := range ts.Type.(*ast.InterfaceType).Methods.List {
+		ft, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) == 0 {
+			log.Fatalf("%s: embedded interfaces are not supported", ts.Name.Name)
+		}
+
+		s.methods = append(s.methods, method{
+			name:    m.Names[0].Name,
+			params:  g.types(ft.Params),
+			results: g.types(ft.Results),
+		})
+	}
+
+	return s
+}
+
+// types returns the types of the list, qualified for the onappgomock
+// package.
+func (g *generator) types(fl *ast.FieldList) []string {
+	if fl == nil {
+		return nil
+	}
+
+	var types []string
+	for _, f := range fl.List {
+		if _, ok := f.Type.(*ast.Ellipsis); ok {
+			log.Fatal("variadic methods are not supported")
+		}
+
+		typ := g.qualify(f.Type)
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, typ)
+		}
+	}
+
+	return types
+}
+
+// qualify prints the type expression with the types of the package prefixed
+// by its name, and records the imported packages.
+func (g *generator) qualify(expr ast.Expr) string {
+	expr = copyExpr(expr)
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok {
+				g.imports[x.Name] = true
+			}
+			return false
+		case *ast.Ident:
+			if g.exported[n.Name] {
+				n.Name = pkgName + "." + n.Name
+				g.imports[pkgName] = true
+			}
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	printer.Fprint(&buf, g.fset, expr)
+	return buf.String()
+}
+
+// copyExpr copies the type expressions found in the method signatures.
+func copyExpr(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		c := *e
+		return &c
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: copyExpr(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: copyExpr(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: copyExpr(e.Key), Value: copyExpr(e.Value)}
+	case *ast.SelectorExpr:
+		return &ast.SelectorExpr{X: copyExpr(e.X), Sel: e.Sel}
+	}
+
+	return expr
+}
+
+var importPaths = map[string]string{
+	pkgName:   "github.com/OnApp/onapp-sdk-go",
+	"context": "context",
+	"time":    "time",
+}
+
+func (g *generator) generate() ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by mockgen. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package onappgomock")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "import (")
+	var names []string
+	for name := range g.imports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path, ok := importPaths[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown import path of package %s", name)
+		}
+		if name != pkgName {
+			fmt.Fprintf(&b, "%q\n", path)
+		}
+	}
+	if g.imports[pkgName] {
+		fmt.Fprintf(&b, "\n%s %q\n", pkgName, importPaths[pkgName])
+	}
+	fmt.Fprintln(&b, ")")
+
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// Mocks holds a mock of every service of the Client.")
+	fmt.Fprintln(&b, "type Mocks struct {")
+	for _, f := range g.fields {
+		fmt.Fprintf(&b, "%s *%s\n", f.name, f.iface)
+	}
+	fmt.Fprintln(&b, "}")
+
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// NewMocks returns new mocks of every service of the Client.")
+	fmt.Fprintln(&b, "func NewMocks() *Mocks {")
+	fmt.Fprintln(&b, "return &Mocks{")
+	for _, f := range g.fields {
+		fmt.Fprintf(&b, "%s: new(%s),\n", f.name, f.iface)
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b, "}")
+
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// setServices points the services of the client at the mocks.")
+	fmt.Fprintln(&b, "func (m *Mocks) setServices(c *onappgo.Client) {")
+	for _, f := range g.fields {
+		fmt.Fprintf(&b, "c.%s = m.%s\n", f.name, f.name)
+	}
+	fmt.Fprintln(&b, "}")
+
+	for _, s := range g.services {
+		fmt.Fprintln(&b)
+		fmt.Fprintf(&b, "// %s is a mock of onappgo.%s.\n", s.name, s.name)
+		fmt.Fprintf(&b, "type %s struct {\n", s.name)
+		fmt.Fprintln(&b, "Recorder")
+		fmt.Fprintln(&b)
+		for _, m := range s.methods {
+			fmt.Fprintf(&b, "%sFunc func(%s) %s\n", m.name, strings.Join(m.params, ", "), results(m.results))
+		}
+		fmt.Fprintln(&b, "}")
+
+		fmt.Fprintln(&b)
+		fmt.Fprintf(&b, "var _ onappgo.%s = &%s{}\n", s.name, s.name)
+
+		for _, m := range s.methods {
+			args := make([]string, len(m.params))
+			params := make([]string, len(m.params))
+			for i, p := range m.params {
+				args[i] = fmt.Sprintf("a%d", i)
+				params[i] = fmt.Sprintf("a%d %s", i, p)
+			}
+
+			named := make([]string, len(m.results))
+			for i, r := range m.results {
+				named[i] = fmt.Sprintf("r%d %s", i, r)
+			}
+
+			fmt.Fprintln(&b)
+			fmt.Fprintf(&b, "// %s records the call and returns the results of %sFunc, zero values\n", m.name, m.name)
+			fmt.Fprintln(&b, "// if it is nil.")
+			fmt.Fprintf(&b, "func (m *%s) %s(%s) (%s) {\n", s.name, m.name, strings.Join(params, ", "), strings.Join(named, ", "))
+			fmt.Fprintf(&b, "m.record(%q, %s)\n", m.name, strings.Join(args, ", "))
+			fmt.Fprintf(&b, "if m.%sFunc != nil {\n", m.name)
+			if len(m.results) > 0 {
+				fmt.Fprintf(&b, "return m.%sFunc(%s)\n", m.name, strings.Join(args, ", "))
+			} else {
+				fmt.Fprintf(&b, "m.%sFunc(%s)\n", m.name, strings.Join(args, ", "))
+			}
+			fmt.Fprintln(&b, "}")
+			fmt.Fprintln(&b, "return")
+			fmt.Fprintln(&b, "}")
+		}
+	}
+
+	return format.Source(b.Bytes())
+}
+
+func results(types []string) string {
+	if len(types) <= 1 {
+		return strings.Join(types, "")
+	}
+
+	return "(" + strings.Join(types, ", ") + ")"
+}
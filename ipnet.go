@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -153,7 +152,6 @@ func (s *IPNetsServiceOp) Create(ctx context.Context, net int, createRequest *IP
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("IPNet [Create] req: ", req)
 
 	root := new(ipNetRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -181,7 +179,6 @@ func (s *IPNetsServiceOp) Delete(ctx context.Context, net int, id int, meta inte
 	if err != nil {
 		return nil, err
 	}
-	log.Println("IPNet [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -203,7 +200,6 @@ func (s *IPNetsServiceOp) Edit(ctx context.Context, net int, id int, editRequest
 	if err != nil {
 		return nil, err
 	}
-	log.Println("IPNet [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -132,7 +131,6 @@ func (s *IPRangesServiceOp) Create(ctx context.Context, net int, ipnet int, crea
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("IPRange [Create] req: ", req)
 
 	root := new(ipRangeRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -160,7 +158,6 @@ func (s *IPRangesServiceOp) Delete(ctx context.Context, net int, ipnet int, id i
 	if err != nil {
 		return nil, err
 	}
-	log.Println("IPRange [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -182,7 +179,6 @@ func (s *IPRangesServiceOp) Edit(ctx context.Context, net int, ipnet int, id int
 	if err != nil {
 		return nil, err
 	}
-	log.Println("IPRange [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
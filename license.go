@@ -2,7 +2,6 @@ package onappgo
 
 import (
 	"context"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -67,7 +66,6 @@ func (s *LicensesServiceOp) Get(ctx context.Context) (*License, *Response, error
 		return nil, nil, err
 	}
 
-	log.Println("License [Get] req: ", req)
 
 	root := new(licenseRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -95,7 +93,6 @@ func (s *LicensesServiceOp) Edit(ctx context.Context, editRequest *LicenseEditRe
 		return nil, err
 	}
 
-	log.Println("License [Create/Edit] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -132,7 +132,6 @@ func (s *LocationGroupsServiceOp) Refresh(ctx context.Context) (*Response, error
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("LocationGroup [Refresh] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -0,0 +1,161 @@
+package onappgo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// Logger is the structured logger used by the Client. The methods take a
+// message followed by alternating keys and values, as *slog.Logger does.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+// sensitiveFields are the request body fields which are never logged.
+var sensitiveFields = []string{
+	"api_key",
+	"encryption_passphrase",
+	"initial_root_password",
+	"initial_root_password_encryption_key",
+	"password",
+	"password_confirmation",
+	"remote_access_password",
+	"service_password",
+}
+
+// SetLogger is a client option for setting the logger of the requests made
+// to the OnApp API. Nothing is logged by default.
+func SetLogger(l Logger) ClientOpt {
+	return func(c *Client) error {
+		if l == nil {
+			l = nopLogger{}
+		}
+
+		c.logger = l
+		return nil
+	}
+}
+
+// LoggingMiddleware returns a middleware logging the requests like
+// SetLogger, but at any place of the chain of middlewares, for example
+// inside RetryMiddleware to log every attempt.
+func LoggingMiddleware(l Logger) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		if _, ok := l.(nopLogger); ok || l == nil {
+			return next
+		}
+
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			startedAt := time.Now()
+			resp, err := next(ctx, req)
+			logRequest(l, req, resp, err, time.Since(startedAt))
+			return resp, err
+		}
+	}
+}
+
+// loggingMiddleware logs the requests with the logger of the client.
+func (c *Client) loggingMiddleware(next RoundTripFunc) RoundTripFunc {
+	return LoggingMiddleware(c.logger)(next)
+}
+
+// logRequest logs a finished request: failed ones as errors or warnings,
+// successful ones with the debug level. Credentials are never logged.
+func logRequest(l Logger, req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	op := newAPIOperation(req)
+	args := []interface{}{
+		"service", op.Service,
+		"method", op.Method,
+		"http_method", req.Method,
+		"path", req.URL.Path,
+		"duration", duration,
+		"request_id", req.Header.Get(headerRequestID),
+	}
+
+	if body := redactedBody(req); body != "" {
+		args = append(args, "body", body)
+	}
+
+	switch {
+	case err != nil:
+		l.Error("onapp request failed", append(args, "error", err.Error())...)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		l.Error("onapp request failed", append(args, "status", resp.StatusCode)...)
+	case resp.StatusCode >= http.StatusBadRequest:
+		l.Warn("onapp request failed", append(args, "status", resp.StatusCode)...)
+	default:
+		l.Debug("onapp request", append(args, "status", resp.StatusCode)...)
+	}
+}
+
+// redactedBody returns the JSON body of the request with the sensitive
+// fields redacted.
+func redactedBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil || len(data) == 0 {
+		return ""
+	}
+
+	return string(RedactJSON(data))
+}
+
+// RedactJSON returns the JSON document with the values of the password like
+// fields replaced, or nil if data isn't valid JSON.
+func RedactJSON(data []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+
+	data, err := json.Marshal(redact(v))
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func redact(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, field := range val {
+			if StringInSlice(sensitiveFields, k, true) || strings.Contains(strings.ToLower(k), "password") {
+				val[k] = redacted
+			} else {
+				val[k] = redact(field)
+			}
+		}
+	case []interface{}:
+		for i := range val {
+			val[i] = redact(val[i])
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,89 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type logEntry struct {
+	level  string
+	msg    string
+	fields map[string]interface{}
+}
+
+type testLogger struct {
+	entries []logEntry
+}
+
+func (l *testLogger) log(level, msg string, args ...interface{}) {
+	e := logEntry{level: level, msg: msg, fields: make(map[string]interface{})}
+	for i := 0; i+1 < len(args); i += 2 {
+		e.fields[args[i].(string)] = args[i+1]
+	}
+	l.entries = append(l.entries, e)
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("debug", msg, args...) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("info", msg, args...) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("warn", msg, args...) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("error", msg, args...) }
+
+func TestDo_logger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logger := &testLogger{}
+	require.NoError(t, SetLogger(logger)(client))
+
+	mux.HandleFunc("/virtual_machines.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"virtual_machine":{"id":1}}`)
+	})
+
+	createRequest := &VirtualMachineCreateRequest{
+		Hostname:            "vm",
+		InitialRootPassword: "secret-password",
+	}
+	_, resp, err := client.VirtualMachines.Create(ctx, createRequest)
+	require.NoError(t, err)
+
+	require.Len(t, logger.entries, 1)
+	e := logger.entries[0]
+	require.Equal(t, "debug", e.level)
+	require.Equal(t, "VirtualMachines", e.fields["service"])
+	require.Equal(t, "Create", e.fields["method"])
+	require.Equal(t, http.MethodPost, e.fields["http_method"])
+	require.Equal(t, http.StatusOK, e.fields["status"])
+	require.Equal(t, resp.RequestID, e.fields["request_id"])
+
+	body := e.fields["body"].(string)
+	require.Contains(t, body, `"hostname":"vm"`)
+	require.Contains(t, body, redacted)
+	require.False(t, strings.Contains(body, "secret-password"))
+	for _, v := range e.fields {
+		require.NotContains(t, fmt.Sprint(v), token)
+	}
+}
+
+func TestNewAPIOperation(t *testing.T) {
+	cases := []struct {
+		method, path, name, route string
+	}{
+		{http.MethodGet, "/virtual_machines.json", "VirtualMachines.List", "virtual_machines"},
+		{http.MethodGet, "/virtual_machines/5.json", "VirtualMachines.Get", "virtual_machines/:id"},
+		{http.MethodPost, "/virtual_machines/5/startup.json", "VirtualMachines.Startup", "virtual_machines/:id/startup"},
+		{http.MethodDelete, "/settings/disks/7.json", "Disks.Delete", "settings/disks/:id"},
+		{http.MethodPost, "/users/1/user_white_lists.json", "UserWhiteLists.Create", "users/:id/user_white_lists"},
+		{http.MethodPut, "/settings/networks/1/ip_nets/2.json", "IPNets.Edit", "settings/networks/:id/ip_nets/:id"},
+	}
+
+	for _, c := range cases {
+		req, _ := http.NewRequest(c.method, "http://cp"+c.path, nil)
+		op := newAPIOperation(req)
+		require.Equal(t, c.name, op.Name(), c.path)
+		require.Equal(t, c.route, op.Route, c.path)
+	}
+}
@@ -0,0 +1,112 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// errNoResponse is returned instead of a nil response with a nil error.
+var errNoResponse = errors.New("onapp: a middleware returned neither a response nor an error")
+
+// RoundTripFunc sends a request made by Client.Do and returns its response.
+// The response body is read and closed by Client.Do.
+type RoundTripFunc func(ctx context.Context, req *http.Request) (*http.Response, error)
+
+// Middleware wraps the RoundTripFunc sending the requests of a Client. It can
+// change the request, answer it without calling next, or change the response
+// and the error:
+//
+//	noDeletes := func(next onappgo.RoundTripFunc) onappgo.RoundTripFunc {
+//		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+//			if req.Method == http.MethodDelete {
+//				return nil, errors.New("deletes are not allowed in production")
+//			}
+//			return next(ctx, req)
+//		}
+//	}
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use is a client option for adding middlewares to the chain of the
+// requests, the first one runs first. They run inside the metrics and the
+// logging of the client, and outside its dry-run mode, cache, retries,
+// credentials refresh and rate limits, in this order: every attempt of a
+// retried request waits for the rate limits. RetryMiddleware,
+// LoggingMiddleware and MetricsMiddleware can be used to place those
+// elsewhere in the chain.
+func Use(mws ...Middleware) ClientOpt {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, mws...)
+		return nil
+	}
+}
+
+// Chain composes the middlewares into one, the first one runs first. A
+// middleware returning neither a response nor an error returns an error
+// instead, so the middlewares before it always get one or the other.
+func Chain(mws ...Middleware) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		for i := len(mws) - 1; i >= 0; i-- {
+			next = answered(mws[i](next))
+		}
+
+		return next
+	}
+}
+
+// answered makes the RoundTripFunc return an error instead of a nil response
+// with a nil error.
+func answered(next RoundTripFunc) RoundTripFunc {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		resp, err := next(ctx, req)
+		if resp == nil && err == nil {
+			return nil, errNoResponse
+		}
+
+		return resp, err
+	}
+}
+
+// requestState is shared by Client.Do and the middlewares of the client
+// handling one request.
+type requestState struct {
+	cached bool
+	dryRun bool
+}
+
+type requestStateKey struct{}
+
+func withRequestState(ctx context.Context, st *requestState) context.Context {
+	return context.WithValue(ctx, requestStateKey{}, st)
+}
+
+// stateOf returns the state of the request made with ctx, a throwaway one if
+// the middleware is called outside of Client.Do.
+func stateOf(ctx context.Context) *requestState {
+	if st, ok := ctx.Value(requestStateKey{}).(*requestState); ok {
+		return st
+	}
+
+	return &requestState{}
+}
+
+// roundTrip returns the chain of middlewares sending the requests of
+// Client.Do.
+func (c *Client) roundTrip() RoundTripFunc {
+	mws := []Middleware{c.metricsMiddleware, c.loggingMiddleware}
+	mws = append(mws, c.middlewares...)
+	mws = append(mws,
+		c.dryRunMiddleware,
+		c.cacheMiddleware,
+		c.retryMiddleware,
+		c.authenticationMiddleware,
+		c.throttleMiddleware,
+	)
+
+	return Chain(mws...)(c.send)
+}
+
+// send sends the request with the HTTP client.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return DoRequestWithClient(ctx, c.client, req)
+}
@@ -0,0 +1,111 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestUse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	errDeleteForbidden := errors.New("deletes are not allowed")
+	var calls []string
+
+	require.NoError(t, Use(
+		func(next RoundTripFunc) RoundTripFunc {
+			return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				calls = append(calls, req.Method+" "+req.URL.Path)
+				req.Header.Set("X-Audit", "inventory")
+				return next(ctx, req)
+			}
+		},
+		func(next RoundTripFunc) RoundTripFunc {
+			return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				if req.Method == http.MethodDelete {
+					return nil, errDeleteForbidden
+				}
+				return next(ctx, req)
+			}
+		},
+		RetryMiddleware(RetryPolicy{MaxRetries: 1, MinBackoff: 1}),
+	)(client))
+
+	attempts := 0
+	mux.HandleFunc("/users/1.json", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Audit"); got != "inventory" {
+			t.Errorf("X-Audit header = %v, expected %v", got, "inventory")
+		}
+		if r.Method == http.MethodDelete {
+			t.Error("DELETE sent")
+		}
+
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"user": {"id": 1}}`)
+	})
+
+	user, _, err := client.Users.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, user.ID)
+	require.Equal(t, 2, attempts)
+
+	_, err = client.Users.Delete(ctx, 1, nil)
+	require.ErrorIs(t, err, errDeleteForbidden)
+
+	require.Equal(t, []string{"GET /users/1.json", "DELETE /users/1.json"}, calls)
+}
+
+func TestUse_noResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	require.NoError(t, SetLogger(&testLogger{})(client))
+	require.NoError(t, SetMeterProvider(noop.NewMeterProvider())(client))
+	require.NoError(t, Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return nil, nil
+		}
+	})(client))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "users/1.json", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.ErrorIs(t, err, errNoResponse)
+}
+
+func TestDo_retriesRateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	require.NoError(t, SetRateLimit(RateLimit{RequestsPerSecond: 20, Burst: 1})(client))
+	require.NoError(t, SetRetryPolicy(RetryPolicy{MaxRetries: 2, MinBackoff: time.Nanosecond, MaxBackoff: time.Nanosecond})(client))
+
+	attempts := 0
+	mux.HandleFunc("/users/1.json", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"user": {"id": 1}}`)
+	})
+
+	// every attempt waits for a token, 50ms apart
+	startedAt := time.Now()
+	_, _, err := client.Users.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+	require.GreaterOrEqual(t, time.Since(startedAt), 90*time.Millisecond)
+}
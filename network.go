@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -157,7 +156,6 @@ func (s *NetworksServiceOp) Create(ctx context.Context, createRequest *NetworkCr
 	if err != nil {
 		return nil, nil, err
 	}
-	fmt.Println("Network [Create] req: ", req)
 
 	root := new(networkRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -184,7 +182,6 @@ func (s *NetworksServiceOp) Delete(ctx context.Context, id int, meta interface{}
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("Network [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -205,7 +202,6 @@ func (s *NetworksServiceOp) Edit(ctx context.Context, id int, editRequest *Netwo
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Network [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
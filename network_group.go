@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -141,7 +140,6 @@ func (s *NetworkGroupsServiceOp) Create(ctx context.Context, createRequest *Netw
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("NetworkGroup [Create] req: ", req)
 
 	root := new(networkZoneRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -168,7 +166,6 @@ func (s *NetworkGroupsServiceOp) Delete(ctx context.Context, id int, meta interf
 	if err != nil {
 		return nil, err
 	}
-	log.Println("NetworkGroup [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -189,7 +186,6 @@ func (s *NetworkGroupsServiceOp) Edit(ctx context.Context, id int, editRequest *
 	if err != nil {
 		return nil, err
 	}
-	log.Println("NetworkGroup [Edit] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
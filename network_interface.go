@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -147,7 +146,6 @@ func (s *NetworkInterfacesServiceOp) Create(ctx context.Context, vmID int, creat
 	if err != nil {
 		return nil, nil, err
 	}
-	fmt.Println("NetworkInterface [Create] req: ", req)
 
 	root := new(networkInterfaceRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -175,7 +173,6 @@ func (s *NetworkInterfacesServiceOp) Delete(ctx context.Context, vmID int, id in
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("NetworkInterface [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -197,7 +194,6 @@ func (s *NetworkInterfacesServiceOp) Edit(ctx context.Context, vmID int, id int,
 	if err != nil {
 		return nil, err
 	}
-	log.Println("NetworkInterface [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
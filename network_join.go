@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -150,7 +149,6 @@ func (s *NetworkJoinsServiceOp) Create(ctx context.Context, createRequest *Netwo
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("NetworkJoin [Create] req: ", req)
 
 	root := new(networkJoinRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -188,7 +186,6 @@ func (s *NetworkJoinsServiceOp) Delete(ctx context.Context, deleteRequest *Netwo
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("NetworkJoin [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
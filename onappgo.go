@@ -3,7 +3,6 @@ package onappgo
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,10 +12,12 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/OnApp/onapp-sdk-go/version"
 
 	"github.com/google/go-querystring/query"
+	"github.com/google/uuid"
 )
 
 var userAgent = "onappgo/" + sdk.String()
@@ -35,17 +36,26 @@ const (
 // Client manages communication with OnApp API.
 type Client struct {
 	// HTTP client used to communicate with the OnApp SDK API.
-	client    *http.Client
+	client *http.Client
+
+	// transport configured by the TLS options, a copy of the one of client,
+	// nil when it is not an *http.Transport
 	transport *http.Transport
 
+	// ownTransport is set once the TLS options made the client use transport
+	ownTransport bool
+
+	// Wrappers of the transport set by WrapTransport, the innermost first
+	wrappers []func(http.RoundTripper) http.RoundTripper
+
 	// Base URL for API requests.
 	BaseURL *url.URL
 
 	// User agent for client
 	UserAgent string
 
-	apiUser     string
-	apiPassword string
+	// Credentials of the requests, none by default
+	credentials CredentialsProvider
 
 	// Services used for communicating with the API
 	AccessControls            AccessControlsService
@@ -100,6 +110,30 @@ type Client struct {
 
 	// Optional function called after every successful request made to the OnApp APIs
 	onRequestCompleted RequestCompletionCallback
+
+	// Middlewares added by Use, see roundTrip for the built-in ones
+	middlewares []Middleware
+
+	// Optional retries of the requests failed with a transient error
+	retryPolicy *RetryPolicy
+
+	// Optional client side rate limits and concurrency caps
+	throttling *throttle
+
+	// Structured logger of the requests, nothing is logged by default
+	logger Logger
+
+	// Optional OpenTelemetry tracing and metrics
+	telemetry telemetry
+
+	// Version of the Control Panel, requested by the first feature check
+	serverVersion serverVersion
+
+	// Optional cache of the responses of read-mostly endpoints
+	cache *responseCache
+
+	// Plan of the dry-run mode, the requests are sent if nil
+	dryRun *Plan
 }
 
 // RequestCompletionCallback defines the type of the request callback function
@@ -122,6 +156,24 @@ type Response struct {
 	// Links that were returned with the response. These are parsed from
 	// request body and not the header.
 	Links *Links
+
+	// RequestID is the X-Request-Id of the request, as echoed by OnApp or
+	// as sent by the client.
+	RequestID string
+
+	// StartedAt is the time the request was sent at.
+	StartedAt time.Time
+
+	// Duration is the time it took to receive the response headers.
+	Duration time.Duration
+
+	// Cached is set when the response was served from the cache, see
+	// SetCache.
+	Cached bool
+
+	// DryRun is set when the request was recorded in the plan of the
+	// dry-run mode instead of being sent, see SetDryRun.
+	DryRun bool
 }
 
 // An ErrorResponse reports the error caused by an API request
@@ -131,6 +183,9 @@ type ErrorResponse struct {
 
 	// Error messages
 	Errors map[string][]string `json:"errors,omitempty"`
+
+	// Message of the {"error": "..."} or plain text error bodies
+	Message string `json:"error,omitempty"`
 }
 
 func addOptions(s string, opt interface{}) (string, error) {
@@ -168,19 +223,17 @@ func NewClient(httpClient *http.Client) *Client {
 
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent}
+	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent, logger: nopLogger{}}
 
-	if t, ok := http.DefaultTransport.(*http.Transport); ok {
-		c.transport = &http.Transport{
-			DialContext:           t.DialContext,
-			ExpectContinueTimeout: t.ExpectContinueTimeout,
-			IdleConnTimeout:       t.IdleConnTimeout,
-			MaxIdleConns:          t.MaxIdleConns,
-			Proxy:                 t.Proxy,
-			TLSHandshakeTimeout:   t.TLSHandshakeTimeout,
-		}
-	} else {
-		c.transport = new(http.Transport)
+	// the TLS options configure a copy of the transport, the HTTP client
+	// given by the caller is never modified. Other round trippers can't be
+	// configured, the TLS options fail with them.
+	rt := httpClient.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	if t, ok := rt.(*http.Transport); ok {
+		c.transport = t.Clone()
 	}
 
 	c.AccessControls = &AccessControlsServiceOp{client: c}
@@ -236,21 +289,6 @@ func NewClient(httpClient *http.Client) *Client {
 	return c
 }
 
-func (c *Client) certificate() *tls.Certificate {
-	certs := c.transport.TLSClientConfig.Certificates
-	if len(certs) == 0 {
-		return nil
-	}
-	return &certs[0]
-}
-
-func (c *Client) setCertificate(cert tls.Certificate) {
-	t := c.client.Transport.(*http.Transport)
-
-	// Extension or HoK certificate
-	t.TLSClientConfig.Certificates = []tls.Certificate{cert}
-}
-
 // ClientOpt are options for New.
 type ClientOpt func(*Client) error
 
@@ -287,11 +325,42 @@ func SetUserAgent(ua string) ClientOpt {
 	}
 }
 
+// SetTimeout is a client option for setting the time limit of every HTTP
+// request, a retried request gets a new limit. The HTTP client given to New
+// is copied, not modified.
+func SetTimeout(d time.Duration) ClientOpt {
+	return func(c *Client) error {
+		hc := *c.client
+		hc.Timeout = d
+		c.client = &hc
+		return nil
+	}
+}
+
 // SetBasicAuth is a client option for setting the user and password for API call.
 func SetBasicAuth(user, password string) ClientOpt {
+	return SetCredentialsProvider(StaticCredentials(Credentials{User: user, APIKey: password}))
+}
+
+// WrapTransport is a client option for wrapping the HTTP transport of the
+// client, for example to record or replay the requests. The HTTP client given
+// to New is copied, not modified.
+func WrapTransport(wrap func(http.RoundTripper) http.RoundTripper) ClientOpt {
 	return func(c *Client) error {
-		c.apiUser = user
-		c.apiPassword = password
+		c.wrappers = append(c.wrappers, wrap)
+		if c.ownTransport {
+			c.useTransport()
+			return nil
+		}
+
+		base := c.client.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+
+		hc := *c.client
+		hc.Transport = wrap(base)
+		c.client = &hc
 		return nil
 	}
 }
@@ -299,18 +368,18 @@ func SetBasicAuth(user, password string) ClientOpt {
 // SetAllowUnverifiedSSL is a client option for setting allowUnverifiedSSL.
 func SetAllowUnverifiedSSL(isv bool) ClientOpt {
 	return func(c *Client) error {
-		c.transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: isv}
+		cfg, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		cfg.InsecureSkipVerify = isv
 
 		// Don't bother setting DialTLS if InsecureSkipVerify=true
 		if !isv {
 			c.transport.DialTLSContext = nil
 		}
 
-		c.client.Transport = c.transport
-
-		if cert := c.certificate(); cert != nil {
-			c.setCertificate(*cert)
-		}
+		c.useTransport()
 		return nil
 	}
 }
@@ -334,23 +403,50 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(buf.Bytes()))
 	if err != nil {
 		return nil, err
 	}
 
+	// the body must be replayable for the retries
+	data := buf.Bytes()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
 	// maybe resolve problem with EOF error during POST request
 	req.Close = true
 
 	req.Header.Add("Content-Type", mediaType)
 	req.Header.Add("Accept", mediaType)
 	req.Header.Add("User-Agent", c.UserAgent)
+	req.Header.Set(headerRequestID, requestIDFromContext(ctx))
 
-	req.SetBasicAuth(c.apiUser, c.apiPassword)
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the X-Request-Id to use for
+// the requests created with it. By default every request gets a random ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func requestIDFromContext(ctx context.Context) string {
+	if ctx != nil {
+		if id, ok := ctx.Value(requestIDKey{}).(string); ok && id != "" {
+			return id
+		}
+	}
+
+	return uuid.New().String()
+}
+
 // OnRequestCompleted sets the OnApp API request completion callback
 func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
 	c.onRequestCompleted = rc
@@ -366,6 +462,19 @@ func newResponse(r *http.Response) *Response {
 	return response
 }
 
+// serverStartedAt estimates the time the request was received by OnApp in
+// the clock of the OnApp server, falling back to the local clock.
+func (r *Response) serverStartedAt() time.Time {
+	if r.Response != nil {
+		if date, err := http.ParseTime(r.Header.Get("Date")); err == nil {
+			// Date has a second resolution
+			return date.Add(-r.Duration - time.Second)
+		}
+	}
+
+	return r.StartedAt
+}
+
 func (r *Response) populateLinks() {
 	limit := r.Header.Get(headerPerPage)
 	page := r.Header.Get(headerPage)
@@ -380,7 +489,9 @@ func (r *Response) populateLinks() {
 	r.Links.PerPage, _ = strconv.Atoi(limit)
 	r.Links.CurPage, _ = strconv.Atoi(page)
 	r.Links.Total, _ = strconv.Atoi(total)
-	r.Links.NumPages = int(r.Links.Total/r.Links.PerPage) + 1
+	if r.Links.PerPage > 0 {
+		r.Links.NumPages = (r.Links.Total + r.Links.PerPage - 1) / r.Links.PerPage
+	}
 }
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
@@ -390,7 +501,17 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	resp := &http.Response{}
 	err := *new(error)
 
-	resp, err = DoRequestWithClient(ctx, c.client, req)
+	ctx, span := c.startRequestSpan(ctx, req)
+	defer span.End()
+
+	st := new(requestState)
+	ctx = withRequestState(ctx, st)
+
+	startedAt := time.Now()
+	resp, err = c.roundTrip()(ctx, req)
+	duration := time.Since(startedAt)
+
+	endRequestSpan(span, resp, err)
 	if err != nil {
 		return nil, err
 	}
@@ -406,6 +527,14 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}()
 
 	response := newResponse(resp)
+	response.StartedAt = startedAt
+	response.Duration = duration
+	response.Cached = st.cached
+	response.DryRun = st.dryRun
+	response.RequestID = resp.Header.Get(headerRequestID)
+	if response.RequestID == "" {
+		response.RequestID = req.Header.Get(headerRequestID)
+	}
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -423,6 +552,10 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 			if err != nil {
 				return nil, err
 			}
+
+			if root, ok := v.(*transactionRoot); ok && root.Transaction != nil {
+				span.SetAttributes(attrTransactionID.Int(root.Transaction.ID))
+			}
 		}
 	}
 
@@ -447,7 +580,8 @@ func (r *ErrorResponse) Error() string {
 
 // CheckResponse checks the API response for errors, and returns them if present. A response is considered an
 // error if it has a status code outside the 200 range. API error responses are expected to have either no response
-// body, or a JSON response body that maps to ErrorResponse. Any other response body will be silently ignored.
+// body, a JSON response body with the "errors" or "error" fields, or a plain text body. The returned
+// *ErrorResponse can be classified with Kind and the IsNotFound like helpers.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; c >= 200 && c <= 299 {
 		return nil
@@ -456,23 +590,28 @@ func CheckResponse(r *http.Response) error {
 	errorResponse := &ErrorResponse{Response: r}
 	data, err := ioutil.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
-		json.Unmarshal(data, errorResponse)
+		errorResponse.parse(data)
 	}
 
 	return errorResponse
 }
 
-// Version return OnApp endpoint version
+// Version return OnApp endpoint version, see ServerVersion for the parsed
+// and cached version.
 func (c *Client) Version() (string, *Response, error) {
+	return c.version(context.TODO())
+}
+
+func (c *Client) version(ctx context.Context) (string, *Response, error) {
 	path := fmt.Sprintf("version%s", apiFormat)
 
-	req, err := c.NewRequest(context.TODO(), http.MethodGet, path, nil)
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return "", nil, err
 	}
 
 	var res map[string]string
-	resp, err := c.Do(context.TODO(), req, &res)
+	resp, err := c.Do(ctx, req, &res)
 	if err != nil {
 		return "", resp, err
 	}
@@ -484,6 +623,10 @@ func (c *Client) Version() (string, *Response, error) {
 func (r *ErrorResponse) String() string {
 	var str string
 
+	if r.Message != "" {
+		str = "\n" + r.Message
+	}
+
 	for name, message := range r.Errors {
 		str = str + fmt.Sprintf("\n%s %s", name, message)
 	}
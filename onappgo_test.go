@@ -106,7 +106,7 @@ func testClientServices(t *testing.T, c *Client) {
 		"Configurations",
 		"UserGroups",
 		"FirewallRules",
-		"UserWhiteList",
+		"UserWhiteLists",
 	}
 
 	cp := reflect.ValueOf(c)
@@ -158,7 +158,7 @@ func TestNewRequest(t *testing.T) {
 	inURL, outURL := "/foo", defaultBaseURL+"/foo"
 	inBody, outBody := &VirtualMachineCreateRequest{Hostname: "l"},
 		`{"acceleration_allowed":false,"hostname":"l",`+
-			`"required_ip_address_assignment":false,"required_virtual_machine_build":false,`+
+			`"required_virtual_machine_build":false,`+
 			`"required_virtual_machine_startup":false,"virsh_console":false}`+"\n"
 	req, _ := c.NewRequest(ctx, http.MethodGet, inURL, inBody)
 
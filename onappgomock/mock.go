@@ -0,0 +1,84 @@
+// Package onappgomock provides mocks of the services of the onappgo Client,
+// for the unit tests of the code using it without an OnApp server.
+//
+// Every mock records its calls and returns the results of the stub function
+// of the method, or zero values if it isn't set:
+//
+//	client, mocks := onappgomock.NewClient()
+//	mocks.VirtualMachines.GetFunc = func(ctx context.Context, id int) (*onappgo.VirtualMachine, *onappgo.Response, error) {
+//		return &onappgo.VirtualMachine{ID: id, Booted: true}, nil, nil
+//	}
+//
+//	err := codeUnderTest(client)
+//	calls := mocks.VirtualMachines.CallsTo("Get")
+//
+// The mocks are generated from the interfaces of the onappgo package.
+package onappgomock
+
+//go:generate go run ../internal/cmd/mockgen -src .. -out mocks_gen.go
+
+import (
+	"sync"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+// Call is a recorded call of a mock method.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls of a mock. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls, the oldest first.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of the method, the oldest first.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+// NewClient returns a client whose services are new mocks, and the mocks.
+// Requests made with the client directly are sent to the default base URL.
+func NewClient() (*onappgo.Client, *Mocks) {
+	m := NewMocks()
+	c := onappgo.NewClient(nil)
+	m.setServices(c)
+
+	return c, m
+}
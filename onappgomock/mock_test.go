@@ -0,0 +1,35 @@
+package onappgomock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+func TestNewClient(t *testing.T) {
+	ctx := context.Background()
+	client, mocks := NewClient()
+
+	mocks.VirtualMachines.GetFunc = func(ctx context.Context, id int) (*onappgo.VirtualMachine, *onappgo.Response, error) {
+		return &onappgo.VirtualMachine{ID: id, Booted: true}, nil, nil
+	}
+
+	vm, _, err := client.VirtualMachines.Get(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, 42, vm.ID)
+
+	trx, _, err := client.VirtualMachineActions.Startup(ctx, 42)
+	require.NoError(t, err)
+	require.Nil(t, trx)
+
+	calls := mocks.VirtualMachines.CallsTo("Get")
+	require.Len(t, calls, 1)
+	require.Equal(t, 42, calls[0].Args[1])
+	require.Len(t, mocks.VirtualMachineActions.Calls(), 1)
+
+	mocks.VirtualMachines.Reset()
+	require.Empty(t, mocks.VirtualMachines.Calls())
+}
@@ -0,0 +1,3466 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package onappgomock
+
+import (
+	"context"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+// Mocks holds a mock of every service of the Client.
+type Mocks struct {
+	AccessControls            *AccessControlsService
+	BackupResources           *BackupResourcesService
+	BackupResourceZones       *BackupResourceZonesService
+	Backups                   *BackupsService
+	BackupServerGroups        *BackupServerGroupsService
+	BackupServerJoins         *BackupServerJoinsService
+	BackupServers             *BackupServersService
+	Buckets                   *BucketsService
+	CloudbootComputeResources *CloudbootComputeResourcesService
+	CloudbootIPAddresses      *CloudbootIPAddressesService
+	Configurations            *ConfigurationsService
+	DataStoreGroups           *DataStoreGroupsService
+	DataStoreJoins            *DataStoreJoinsService
+	DataStores                *DataStoresService
+	Disks                     *DisksService
+	Engines                   *EnginesService
+	FirewallRules             *FirewallRulesService
+	HypervisorGroups          *HypervisorGroupsService
+	Hypervisors               *HypervisorsService
+	HypervisorZones           *HypervisorZonesService
+	ImageTemplateGroups       *ImageTemplateGroupsService
+	ImageTemplates            *ImageTemplatesService
+	InstancePackages          *InstancePackagesService
+	IntegratedDataStores      *IntegratedDataStoresService
+	IPAddresses               *IPAddressesService
+	IPNets                    *IPNetsService
+	IPRanges                  *IPRangesService
+	Licenses                  *LicensesService
+	LocationGroups            *LocationGroupsService
+	NetworkGroups             *NetworkGroupsService
+	NetworkInterfaces         *NetworkInterfacesService
+	NetworkJoins              *NetworkJoinsService
+	Networks                  *NetworksService
+	RateCards                 *RateCardsService
+	RecipeGroups              *RecipeGroupsService
+	Recipes                   *RecipesService
+	RecipeSteps               *RecipeStepsService
+	RecipeJoins               *RecipeJoinsService
+	RemoteTemplates           *RemoteTemplatesService
+	Resolvers                 *ResolversService
+	Roles                     *RolesService
+	SoftwareLicenses          *SoftwareLicensesService
+	SSHKeys                   *SSHKeysService
+	Transactions              *TransactionsService
+	UserGroups                *UserGroupsService
+	Users                     *UsersService
+	UserWhiteLists            *UserWhiteListsService
+	VirtualMachineActions     *VirtualMachineActionsService
+	VirtualMachines           *VirtualMachinesService
+}
+
+// NewMocks returns new mocks of every service of the Client.
+func NewMocks() *Mocks {
+	return &Mocks{
+		AccessControls:            new(AccessControlsService),
+		BackupResources:           new(BackupResourcesService),
+		BackupResourceZones:       new(BackupResourceZonesService),
+		Backups:                   new(BackupsService),
+		BackupServerGroups:        new(BackupServerGroupsService),
+		BackupServerJoins:         new(BackupServerJoinsService),
+		BackupServers:             new(BackupServersService),
+		Buckets:                   new(BucketsService),
+		CloudbootComputeResources: new(CloudbootComputeResourcesService),
+		CloudbootIPAddresses:      new(CloudbootIPAddressesService),
+		Configurations:            new(ConfigurationsService),
+		DataStoreGroups:           new(DataStoreGroupsService),
+		DataStoreJoins:            new(DataStoreJoinsService),
+		DataStores:                new(DataStoresService),
+		Disks:                     new(DisksService),
+		Engines:                   new(EnginesService),
+		FirewallRules:             new(FirewallRulesService),
+		HypervisorGroups:          new(HypervisorGroupsService),
+		Hypervisors:               new(HypervisorsService),
+		HypervisorZones:           new(HypervisorZonesService),
+		ImageTemplateGroups:       new(ImageTemplateGroupsService),
+		ImageTemplates:            new(ImageTemplatesService),
+		InstancePackages:          new(InstancePackagesService),
+		IntegratedDataStores:      new(IntegratedDataStoresService),
+		IPAddresses:               new(IPAddressesService),
+		IPNets:                    new(IPNetsService),
+		IPRanges:                  new(IPRangesService),
+		Licenses:                  new(LicensesService),
+		LocationGroups:            new(LocationGroupsService),
+		NetworkGroups:             new(NetworkGroupsService),
+		NetworkInterfaces:         new(NetworkInterfacesService),
+		NetworkJoins:              new(NetworkJoinsService),
+		Networks:                  new(NetworksService),
+		RateCards:                 new(RateCardsService),
+		RecipeGroups:              new(RecipeGroupsService),
+		Recipes:                   new(RecipesService),
+		RecipeSteps:               new(RecipeStepsService),
+		RecipeJoins:               new(RecipeJoinsService),
+		RemoteTemplates:           new(RemoteTemplatesService),
+		Resolvers:                 new(ResolversService),
+		Roles:                     new(RolesService),
+		SoftwareLicenses:          new(SoftwareLicensesService),
+		SSHKeys:                   new(SSHKeysService),
+		Transactions:              new(TransactionsService),
+		UserGroups:                new(UserGroupsService),
+		Users:                     new(UsersService),
+		UserWhiteLists:            new(UserWhiteListsService),
+		VirtualMachineActions:     new(VirtualMachineActionsService),
+		VirtualMachines:           new(VirtualMachinesService),
+	}
+}
+
+// setServices points the services of the client at the mocks.
+func (m *Mocks) setServices(c *onappgo.Client) {
+	c.AccessControls = m.AccessControls
+	c.BackupResources = m.BackupResources
+	c.BackupResourceZones = m.BackupResourceZones
+	c.Backups = m.Backups
+	c.BackupServerGroups = m.BackupServerGroups
+	c.BackupServerJoins = m.BackupServerJoins
+	c.BackupServers = m.BackupServers
+	c.Buckets = m.Buckets
+	c.CloudbootComputeResources = m.CloudbootComputeResources
+	c.CloudbootIPAddresses = m.CloudbootIPAddresses
+	c.Configurations = m.Configurations
+	c.DataStoreGroups = m.DataStoreGroups
+	c.DataStoreJoins = m.DataStoreJoins
+	c.DataStores = m.DataStores
+	c.Disks = m.Disks
+	c.Engines = m.Engines
+	c.FirewallRules = m.FirewallRules
+	c.HypervisorGroups = m.HypervisorGroups
+	c.Hypervisors = m.Hypervisors
+	c.HypervisorZones = m.HypervisorZones
+	c.ImageTemplateGroups = m.ImageTemplateGroups
+	c.ImageTemplates = m.ImageTemplates
+	c.InstancePackages = m.InstancePackages
+	c.IntegratedDataStores = m.IntegratedDataStores
+	c.IPAddresses = m.IPAddresses
+	c.IPNets = m.IPNets
+	c.IPRanges = m.IPRanges
+	c.Licenses = m.Licenses
+	c.LocationGroups = m.LocationGroups
+	c.NetworkGroups = m.NetworkGroups
+	c.NetworkInterfaces = m.NetworkInterfaces
+	c.NetworkJoins = m.NetworkJoins
+	c.Networks = m.Networks
+	c.RateCards = m.RateCards
+	c.RecipeGroups = m.RecipeGroups
+	c.Recipes = m.Recipes
+	c.RecipeSteps = m.RecipeSteps
+	c.RecipeJoins = m.RecipeJoins
+	c.RemoteTemplates = m.RemoteTemplates
+	c.Resolvers = m.Resolvers
+	c.Roles = m.Roles
+	c.SoftwareLicenses = m.SoftwareLicenses
+	c.SSHKeys = m.SSHKeys
+	c.Transactions = m.Transactions
+	c.UserGroups = m.UserGroups
+	c.Users = m.Users
+	c.UserWhiteLists = m.UserWhiteLists
+	c.VirtualMachineActions = m.VirtualMachineActions
+	c.VirtualMachines = m.VirtualMachines
+}
+
+// AccessControlsService is a mock of onappgo.AccessControlsService.
+type AccessControlsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, *onappgo.ListOptions) ([]onappgo.AccessControl, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.AccessControlCreateRequest) (*onappgo.AccessControl, *onappgo.Response, error)
+	DeleteFunc func(context.Context, *onappgo.AccessControlDeleteRequest, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, *onappgo.AccessControlEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.AccessControlsService = &AccessControlsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *AccessControlsService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.AccessControl, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *AccessControlsService) Create(a0 context.Context, a1 *onappgo.AccessControlCreateRequest) (r0 *onappgo.AccessControl, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *AccessControlsService) Delete(a0 context.Context, a1 *onappgo.AccessControlDeleteRequest, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *AccessControlsService) Edit(a0 context.Context, a1 *onappgo.AccessControlEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1)
+	}
+	return
+}
+
+// BackupResourceZonesService is a mock of onappgo.BackupResourceZonesService.
+type BackupResourceZonesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.BackupResourceZone, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.BackupResourceZone, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.BackupResourceZoneCreateRequest) (*onappgo.BackupResourceZone, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.BackupResourceZonesService = &BackupResourceZonesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *BackupResourceZonesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.BackupResourceZone, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *BackupResourceZonesService) Get(a0 context.Context, a1 int) (r0 *onappgo.BackupResourceZone, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *BackupResourceZonesService) Create(a0 context.Context, a1 *onappgo.BackupResourceZoneCreateRequest) (r0 *onappgo.BackupResourceZone, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *BackupResourceZonesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// BackupResourcesService is a mock of onappgo.BackupResourcesService.
+type BackupResourcesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.BackupResource, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.BackupResource, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.BackupResourceCreateRequest) (*onappgo.BackupResource, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.BackupResourcesService = &BackupResourcesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *BackupResourcesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.BackupResource, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *BackupResourcesService) Get(a0 context.Context, a1 int) (r0 *onappgo.BackupResource, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *BackupResourcesService) Create(a0 context.Context, a1 *onappgo.BackupResourceCreateRequest) (r0 *onappgo.BackupResource, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *BackupResourcesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// BackupServerGroupsService is a mock of onappgo.BackupServerGroupsService.
+type BackupServerGroupsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.BackupServerGroup, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.BackupServerGroup, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.BackupServerGroupCreateRequest) (*onappgo.BackupServerGroup, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.BackupServerGroupEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.BackupServerGroupsService = &BackupServerGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *BackupServerGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.BackupServerGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *BackupServerGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.BackupServerGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *BackupServerGroupsService) Create(a0 context.Context, a1 *onappgo.BackupServerGroupCreateRequest) (r0 *onappgo.BackupServerGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *BackupServerGroupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *BackupServerGroupsService) Edit(a0 context.Context, a1 int, a2 *onappgo.BackupServerGroupEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// BackupServerJoinsService is a mock of onappgo.BackupServerJoinsService.
+type BackupServerJoinsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.BackupServerJoinCreateRequest, *onappgo.ListOptions) ([]onappgo.BackupServerJoin, *onappgo.Response, error)
+	GetFunc    func(context.Context, string, int, int) (*onappgo.BackupServerJoin, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.BackupServerJoinCreateRequest) (*onappgo.BackupServerJoin, *onappgo.Response, error)
+	DeleteFunc func(context.Context, *onappgo.BackupServerJoinDeleteRequest, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.BackupServerJoinsService = &BackupServerJoinsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *BackupServerJoinsService) List(a0 context.Context, a1 *onappgo.BackupServerJoinCreateRequest, a2 *onappgo.ListOptions) (r0 []onappgo.BackupServerJoin, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *BackupServerJoinsService) Get(a0 context.Context, a1 string, a2 int, a3 int) (r0 *onappgo.BackupServerJoin, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2, a3)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *BackupServerJoinsService) Create(a0 context.Context, a1 *onappgo.BackupServerJoinCreateRequest) (r0 *onappgo.BackupServerJoin, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *BackupServerJoinsService) Delete(a0 context.Context, a1 *onappgo.BackupServerJoinDeleteRequest, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// BackupServersService is a mock of onappgo.BackupServersService.
+type BackupServersService struct {
+	Recorder
+
+	ListFunc                          func(context.Context, *onappgo.ListOptions) ([]onappgo.BackupServer, *onappgo.Response, error)
+	GetFunc                           func(context.Context, int) (*onappgo.BackupServer, *onappgo.Response, error)
+	CreateFunc                        func(context.Context, *onappgo.BackupServerCreateRequest) (*onappgo.BackupServer, *onappgo.Response, error)
+	DeleteFunc                        func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc                          func(context.Context, int, *onappgo.BackupServerEditRequest) (*onappgo.Response, error)
+	RefreshFunc                       func(context.Context, int) (*onappgo.HardwareDevices, *onappgo.Response, error)
+	AttachFunc                        func(context.Context, int, map[string]interface{}) (*onappgo.Response, error)
+	EditIntegratedStorageSettingsFunc func(context.Context, int, *onappgo.IntegratedStorageSettings) (*onappgo.Response, error)
+}
+
+var _ onappgo.BackupServersService = &BackupServersService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *BackupServersService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.BackupServer, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *BackupServersService) Get(a0 context.Context, a1 int) (r0 *onappgo.BackupServer, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *BackupServersService) Create(a0 context.Context, a1 *onappgo.BackupServerCreateRequest) (r0 *onappgo.BackupServer, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *BackupServersService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *BackupServersService) Edit(a0 context.Context, a1 int, a2 *onappgo.BackupServerEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Refresh records the call and returns the results of RefreshFunc, zero values
+// if it is nil.
+func (m *BackupServersService) Refresh(a0 context.Context, a1 int) (r0 *onappgo.HardwareDevices, r1 *onappgo.Response, r2 error) {
+	m.record("Refresh", a0, a1)
+	if m.RefreshFunc != nil {
+		return m.RefreshFunc(a0, a1)
+	}
+	return
+}
+
+// Attach records the call and returns the results of AttachFunc, zero values
+// if it is nil.
+func (m *BackupServersService) Attach(a0 context.Context, a1 int, a2 map[string]interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Attach", a0, a1, a2)
+	if m.AttachFunc != nil {
+		return m.AttachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// EditIntegratedStorageSettings records the call and returns the results of EditIntegratedStorageSettingsFunc, zero values
+// if it is nil.
+func (m *BackupServersService) EditIntegratedStorageSettings(a0 context.Context, a1 int, a2 *onappgo.IntegratedStorageSettings) (r0 *onappgo.Response, r1 error) {
+	m.record("EditIntegratedStorageSettings", a0, a1, a2)
+	if m.EditIntegratedStorageSettingsFunc != nil {
+		return m.EditIntegratedStorageSettingsFunc(a0, a1, a2)
+	}
+	return
+}
+
+// BackupsService is a mock of onappgo.BackupsService.
+type BackupsService struct {
+	Recorder
+
+	ListFunc                      func(context.Context, int, *onappgo.ListOptions) ([]onappgo.Backup, *onappgo.Response, error)
+	GetFunc                       func(context.Context, int) (*onappgo.Backup, *onappgo.Response, error)
+	CreateFunc                    func(context.Context, *onappgo.BackupCreateRequest) (*onappgo.Backup, *onappgo.Response, error)
+	DeleteFunc                    func(context.Context, int, interface{}) (*onappgo.Response, error)
+	AllComputeResourceBackupsFunc func(context.Context, int) ([]onappgo.Backup, *onappgo.Response, error)
+	ListOfDiskBackupsFunc         func(context.Context, int, int) ([]onappgo.Backup, *onappgo.Response, error)
+	BackupNoteFunc                func(context.Context, int, *onappgo.BackupNoteRequest) (*onappgo.Response, error)
+	ConvertBackupToTemplateFunc   func(context.Context, int, *onappgo.ConvertBackupToTemplateRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.BackupsService = &BackupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *BackupsService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.Backup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *BackupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.Backup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *BackupsService) Create(a0 context.Context, a1 *onappgo.BackupCreateRequest) (r0 *onappgo.Backup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *BackupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// AllComputeResourceBackups records the call and returns the results of AllComputeResourceBackupsFunc, zero values
+// if it is nil.
+func (m *BackupsService) AllComputeResourceBackups(a0 context.Context, a1 int) (r0 []onappgo.Backup, r1 *onappgo.Response, r2 error) {
+	m.record("AllComputeResourceBackups", a0, a1)
+	if m.AllComputeResourceBackupsFunc != nil {
+		return m.AllComputeResourceBackupsFunc(a0, a1)
+	}
+	return
+}
+
+// ListOfDiskBackups records the call and returns the results of ListOfDiskBackupsFunc, zero values
+// if it is nil.
+func (m *BackupsService) ListOfDiskBackups(a0 context.Context, a1 int, a2 int) (r0 []onappgo.Backup, r1 *onappgo.Response, r2 error) {
+	m.record("ListOfDiskBackups", a0, a1, a2)
+	if m.ListOfDiskBackupsFunc != nil {
+		return m.ListOfDiskBackupsFunc(a0, a1, a2)
+	}
+	return
+}
+
+// BackupNote records the call and returns the results of BackupNoteFunc, zero values
+// if it is nil.
+func (m *BackupsService) BackupNote(a0 context.Context, a1 int, a2 *onappgo.BackupNoteRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("BackupNote", a0, a1, a2)
+	if m.BackupNoteFunc != nil {
+		return m.BackupNoteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ConvertBackupToTemplate records the call and returns the results of ConvertBackupToTemplateFunc, zero values
+// if it is nil.
+func (m *BackupsService) ConvertBackupToTemplate(a0 context.Context, a1 int, a2 *onappgo.ConvertBackupToTemplateRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("ConvertBackupToTemplate", a0, a1, a2)
+	if m.ConvertBackupToTemplateFunc != nil {
+		return m.ConvertBackupToTemplateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// BucketsService is a mock of onappgo.BucketsService.
+type BucketsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.Bucket, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.Bucket, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.BucketCreateRequest) (*onappgo.Bucket, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.BucketEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.BucketsService = &BucketsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *BucketsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Bucket, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *BucketsService) Get(a0 context.Context, a1 int) (r0 *onappgo.Bucket, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *BucketsService) Create(a0 context.Context, a1 *onappgo.BucketCreateRequest) (r0 *onappgo.Bucket, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *BucketsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *BucketsService) Edit(a0 context.Context, a1 int, a2 *onappgo.BucketEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// CloudbootComputeResourcesService is a mock of onappgo.CloudbootComputeResourcesService.
+type CloudbootComputeResourcesService struct {
+	Recorder
+
+	ListFunc                        func(context.Context, *onappgo.ListOptions) ([]onappgo.CloudbootComputeResource, *onappgo.Response, error)
+	GetFunc                         func(context.Context, int) (*onappgo.CloudbootComputeResource, *onappgo.Response, error)
+	CreateFunc                      func(context.Context, *onappgo.CloudbootComputeResourceCreateRequest) (*onappgo.CloudbootComputeResource, *onappgo.Response, error)
+	DeleteFunc                      func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc                        func(context.Context, int, *onappgo.CloudbootComputeResourceEditRequest) (*onappgo.Response, error)
+	CloudbootAvailableResourcesFunc func(context.Context) ([]onappgo.Asset, *onappgo.Response, error)
+}
+
+var _ onappgo.CloudbootComputeResourcesService = &CloudbootComputeResourcesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *CloudbootComputeResourcesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.CloudbootComputeResource, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *CloudbootComputeResourcesService) Get(a0 context.Context, a1 int) (r0 *onappgo.CloudbootComputeResource, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *CloudbootComputeResourcesService) Create(a0 context.Context, a1 *onappgo.CloudbootComputeResourceCreateRequest) (r0 *onappgo.CloudbootComputeResource, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *CloudbootComputeResourcesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *CloudbootComputeResourcesService) Edit(a0 context.Context, a1 int, a2 *onappgo.CloudbootComputeResourceEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// CloudbootAvailableResources records the call and returns the results of CloudbootAvailableResourcesFunc, zero values
+// if it is nil.
+func (m *CloudbootComputeResourcesService) CloudbootAvailableResources(a0 context.Context) (r0 []onappgo.Asset, r1 *onappgo.Response, r2 error) {
+	m.record("CloudbootAvailableResources", a0)
+	if m.CloudbootAvailableResourcesFunc != nil {
+		return m.CloudbootAvailableResourcesFunc(a0)
+	}
+	return
+}
+
+// CloudbootIPAddressesService is a mock of onappgo.CloudbootIPAddressesService.
+type CloudbootIPAddressesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.CloudbootIPAddress, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.CloudbootIPAddressCreateRequest) (*onappgo.CloudbootIPAddress, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.CloudbootIPAddressesService = &CloudbootIPAddressesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *CloudbootIPAddressesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.CloudbootIPAddress, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *CloudbootIPAddressesService) Create(a0 context.Context, a1 *onappgo.CloudbootIPAddressCreateRequest) (r0 *onappgo.CloudbootIPAddress, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *CloudbootIPAddressesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ConfigurationsService is a mock of onappgo.ConfigurationsService.
+type ConfigurationsService struct {
+	Recorder
+
+	GetFunc  func(context.Context) (*onappgo.Configuration, *onappgo.Response, error)
+	EditFunc func(context.Context, *map[string]interface{}, int) (*onappgo.Response, error)
+}
+
+var _ onappgo.ConfigurationsService = &ConfigurationsService{}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *ConfigurationsService) Get(a0 context.Context) (r0 *onappgo.Configuration, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *ConfigurationsService) Edit(a0 context.Context, a1 *map[string]interface{}, a2 int) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// DataStoreGroupsService is a mock of onappgo.DataStoreGroupsService.
+type DataStoreGroupsService struct {
+	Recorder
+
+	ListFunc               func(context.Context, *onappgo.ListOptions) ([]onappgo.DataStoreGroup, *onappgo.Response, error)
+	GetFunc                func(context.Context, int) (*onappgo.DataStoreGroup, *onappgo.Response, error)
+	CreateFunc             func(context.Context, *onappgo.DataStoreGroupCreateRequest) (*onappgo.DataStoreGroup, *onappgo.Response, error)
+	DeleteFunc             func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc               func(context.Context, int, *onappgo.DataStoreGroupEditRequest) (*onappgo.Response, error)
+	AttachFunc             func(context.Context, int, int) (*onappgo.Response, error)
+	DetachFunc             func(context.Context, int, int) (*onappgo.Response, error)
+	AttachedDataStoresFunc func(context.Context, int) ([]onappgo.DataStore, *onappgo.Response, error)
+}
+
+var _ onappgo.DataStoreGroupsService = &DataStoreGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.DataStoreGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.DataStoreGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) Create(a0 context.Context, a1 *onappgo.DataStoreGroupCreateRequest) (r0 *onappgo.DataStoreGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) Edit(a0 context.Context, a1 int, a2 *onappgo.DataStoreGroupEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Attach records the call and returns the results of AttachFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) Attach(a0 context.Context, a1 int, a2 int) (r0 *onappgo.Response, r1 error) {
+	m.record("Attach", a0, a1, a2)
+	if m.AttachFunc != nil {
+		return m.AttachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Detach records the call and returns the results of DetachFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) Detach(a0 context.Context, a1 int, a2 int) (r0 *onappgo.Response, r1 error) {
+	m.record("Detach", a0, a1, a2)
+	if m.DetachFunc != nil {
+		return m.DetachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// AttachedDataStores records the call and returns the results of AttachedDataStoresFunc, zero values
+// if it is nil.
+func (m *DataStoreGroupsService) AttachedDataStores(a0 context.Context, a1 int) (r0 []onappgo.DataStore, r1 *onappgo.Response, r2 error) {
+	m.record("AttachedDataStores", a0, a1)
+	if m.AttachedDataStoresFunc != nil {
+		return m.AttachedDataStoresFunc(a0, a1)
+	}
+	return
+}
+
+// DataStoreJoinsService is a mock of onappgo.DataStoreJoinsService.
+type DataStoreJoinsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.DataStoreJoinCreateRequest, *onappgo.ListOptions) ([]onappgo.DataStoreJoin, *onappgo.Response, error)
+	GetFunc    func(context.Context, string, int, int) (*onappgo.DataStoreJoin, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.DataStoreJoinCreateRequest) (*onappgo.DataStoreJoin, *onappgo.Response, error)
+	DeleteFunc func(context.Context, *onappgo.DataStoreJoinDeleteRequest, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.DataStoreJoinsService = &DataStoreJoinsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *DataStoreJoinsService) List(a0 context.Context, a1 *onappgo.DataStoreJoinCreateRequest, a2 *onappgo.ListOptions) (r0 []onappgo.DataStoreJoin, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *DataStoreJoinsService) Get(a0 context.Context, a1 string, a2 int, a3 int) (r0 *onappgo.DataStoreJoin, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2, a3)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *DataStoreJoinsService) Create(a0 context.Context, a1 *onappgo.DataStoreJoinCreateRequest) (r0 *onappgo.DataStoreJoin, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *DataStoreJoinsService) Delete(a0 context.Context, a1 *onappgo.DataStoreJoinDeleteRequest, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// DataStoresService is a mock of onappgo.DataStoresService.
+type DataStoresService struct {
+	Recorder
+
+	ListFunc     func(context.Context, *onappgo.ListOptions) ([]onappgo.DataStore, *onappgo.Response, error)
+	GetFunc      func(context.Context, int) (*onappgo.DataStore, *onappgo.Response, error)
+	CreateFunc   func(context.Context, *onappgo.DataStoreCreateRequest) (*onappgo.DataStore, *onappgo.Response, error)
+	DeleteFunc   func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc     func(context.Context, int, *onappgo.DataStoreEditRequest) (*onappgo.Response, error)
+	IoLimitsFunc func(context.Context, int, *onappgo.IoLimits) (*onappgo.Response, error)
+}
+
+var _ onappgo.DataStoresService = &DataStoresService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *DataStoresService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.DataStore, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *DataStoresService) Get(a0 context.Context, a1 int) (r0 *onappgo.DataStore, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *DataStoresService) Create(a0 context.Context, a1 *onappgo.DataStoreCreateRequest) (r0 *onappgo.DataStore, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *DataStoresService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *DataStoresService) Edit(a0 context.Context, a1 int, a2 *onappgo.DataStoreEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// IoLimits records the call and returns the results of IoLimitsFunc, zero values
+// if it is nil.
+func (m *DataStoresService) IoLimits(a0 context.Context, a1 int, a2 *onappgo.IoLimits) (r0 *onappgo.Response, r1 error) {
+	m.record("IoLimits", a0, a1, a2)
+	if m.IoLimitsFunc != nil {
+		return m.IoLimitsFunc(a0, a1, a2)
+	}
+	return
+}
+
+// DisksService is a mock of onappgo.DisksService.
+type DisksService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.Disk, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.Disk, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.DiskCreateRequest) (*onappgo.Disk, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Transaction, *onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.DiskEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.DisksService = &DisksService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *DisksService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Disk, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *DisksService) Get(a0 context.Context, a1 int) (r0 *onappgo.Disk, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *DisksService) Create(a0 context.Context, a1 *onappgo.DiskCreateRequest) (r0 *onappgo.Disk, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *DisksService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *DisksService) Edit(a0 context.Context, a1 int, a2 *onappgo.DiskEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// EnginesService is a mock of onappgo.EnginesService.
+type EnginesService struct {
+	Recorder
+
+	StatusFunc func(context.Context) (*onappgo.Engine, *onappgo.Response, error)
+	StartFunc  func(context.Context) (*onappgo.Engine, *onappgo.Response, error)
+	StopFunc   func(context.Context) (*onappgo.Engine, *onappgo.Response, error)
+	ReloadFunc func(context.Context) (*onappgo.Engine, *onappgo.Response, error)
+}
+
+var _ onappgo.EnginesService = &EnginesService{}
+
+// Status records the call and returns the results of StatusFunc, zero values
+// if it is nil.
+func (m *EnginesService) Status(a0 context.Context) (r0 *onappgo.Engine, r1 *onappgo.Response, r2 error) {
+	m.record("Status", a0)
+	if m.StatusFunc != nil {
+		return m.StatusFunc(a0)
+	}
+	return
+}
+
+// Start records the call and returns the results of StartFunc, zero values
+// if it is nil.
+func (m *EnginesService) Start(a0 context.Context) (r0 *onappgo.Engine, r1 *onappgo.Response, r2 error) {
+	m.record("Start", a0)
+	if m.StartFunc != nil {
+		return m.StartFunc(a0)
+	}
+	return
+}
+
+// Stop records the call and returns the results of StopFunc, zero values
+// if it is nil.
+func (m *EnginesService) Stop(a0 context.Context) (r0 *onappgo.Engine, r1 *onappgo.Response, r2 error) {
+	m.record("Stop", a0)
+	if m.StopFunc != nil {
+		return m.StopFunc(a0)
+	}
+	return
+}
+
+// Reload records the call and returns the results of ReloadFunc, zero values
+// if it is nil.
+func (m *EnginesService) Reload(a0 context.Context) (r0 *onappgo.Engine, r1 *onappgo.Response, r2 error) {
+	m.record("Reload", a0)
+	if m.ReloadFunc != nil {
+		return m.ReloadFunc(a0)
+	}
+	return
+}
+
+// FirewallRulesService is a mock of onappgo.FirewallRulesService.
+type FirewallRulesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, *onappgo.ListOptions) ([]onappgo.FirewallRule, *onappgo.Response, error)
+	GetFunc    func(context.Context, int, int) (*onappgo.FirewallRule, *onappgo.Response, error)
+	CreateFunc func(context.Context, int, *onappgo.FirewallRuleCreateRequest) (*onappgo.FirewallRule, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, int, *onappgo.FirewallRuleCreateRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.FirewallRulesService = &FirewallRulesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *FirewallRulesService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.FirewallRule, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *FirewallRulesService) Get(a0 context.Context, a1 int, a2 int) (r0 *onappgo.FirewallRule, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *FirewallRulesService) Create(a0 context.Context, a1 int, a2 *onappgo.FirewallRuleCreateRequest) (r0 *onappgo.FirewallRule, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1, a2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *FirewallRulesService) Delete(a0 context.Context, a1 int, a2 int, a3 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2, a3)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *FirewallRulesService) Edit(a0 context.Context, a1 int, a2 int, a3 *onappgo.FirewallRuleCreateRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2, a3)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// HypervisorGroupsService is a mock of onappgo.HypervisorGroupsService.
+type HypervisorGroupsService struct {
+	Recorder
+
+	ListFunc                           func(context.Context, *onappgo.ListOptions) ([]onappgo.HypervisorGroup, *onappgo.Response, error)
+	GetFunc                            func(context.Context, int) (*onappgo.HypervisorGroup, *onappgo.Response, error)
+	CreateFunc                         func(context.Context, *onappgo.HypervisorGroupCreateRequest) (*onappgo.HypervisorGroup, *onappgo.Response, error)
+	DeleteFunc                         func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc                           func(context.Context, int, *onappgo.HypervisorGroupEditRequest) (*onappgo.Response, error)
+	ListOfAttachedComputeResourcesFunc func(context.Context, int) ([]onappgo.Hypervisor, *onappgo.Response, error)
+}
+
+var _ onappgo.HypervisorGroupsService = &HypervisorGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *HypervisorGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.HypervisorGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *HypervisorGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.HypervisorGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *HypervisorGroupsService) Create(a0 context.Context, a1 *onappgo.HypervisorGroupCreateRequest) (r0 *onappgo.HypervisorGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *HypervisorGroupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *HypervisorGroupsService) Edit(a0 context.Context, a1 int, a2 *onappgo.HypervisorGroupEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ListOfAttachedComputeResources records the call and returns the results of ListOfAttachedComputeResourcesFunc, zero values
+// if it is nil.
+func (m *HypervisorGroupsService) ListOfAttachedComputeResources(a0 context.Context, a1 int) (r0 []onappgo.Hypervisor, r1 *onappgo.Response, r2 error) {
+	m.record("ListOfAttachedComputeResources", a0, a1)
+	if m.ListOfAttachedComputeResourcesFunc != nil {
+		return m.ListOfAttachedComputeResourcesFunc(a0, a1)
+	}
+	return
+}
+
+// HypervisorZonesService is a mock of onappgo.HypervisorZonesService.
+type HypervisorZonesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.HypervisorZone, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.HypervisorZone, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Transaction, *onappgo.Response, error)
+}
+
+var _ onappgo.HypervisorZonesService = &HypervisorZonesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *HypervisorZonesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.HypervisorZone, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *HypervisorZonesService) Get(a0 context.Context, a1 int) (r0 *onappgo.HypervisorZone, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *HypervisorZonesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// HypervisorsService is a mock of onappgo.HypervisorsService.
+type HypervisorsService struct {
+	Recorder
+
+	ListFunc                          func(context.Context, *onappgo.ListOptions) ([]onappgo.Hypervisor, *onappgo.Response, error)
+	GetFunc                           func(context.Context, int) (*onappgo.Hypervisor, *onappgo.Response, error)
+	CreateFunc                        func(context.Context, *onappgo.HypervisorCreateRequest) (*onappgo.Hypervisor, *onappgo.Response, error)
+	DeleteFunc                        func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc                          func(context.Context, int, *onappgo.HypervisorEditRequest) (*onappgo.Response, error)
+	RebootFunc                        func(context.Context, int, *onappgo.HypervisorRebootRequest) (*onappgo.Response, error)
+	RefreshFunc                       func(context.Context, int) (*onappgo.HardwareDevices, *onappgo.Response, error)
+	AttachFunc                        func(context.Context, int, map[string]interface{}) (*onappgo.Response, error)
+	GetIntegratedStorageSettingsFunc  func(context.Context, int) (*onappgo.IntegratedStorageSettings, *onappgo.Response, error)
+	EditIntegratedStorageSettingsFunc func(context.Context, int, *onappgo.IntegratedStorageSettings) (*onappgo.Response, error)
+}
+
+var _ onappgo.HypervisorsService = &HypervisorsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Hypervisor, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) Get(a0 context.Context, a1 int) (r0 *onappgo.Hypervisor, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) Create(a0 context.Context, a1 *onappgo.HypervisorCreateRequest) (r0 *onappgo.Hypervisor, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) Edit(a0 context.Context, a1 int, a2 *onappgo.HypervisorEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Reboot records the call and returns the results of RebootFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) Reboot(a0 context.Context, a1 int, a2 *onappgo.HypervisorRebootRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Reboot", a0, a1, a2)
+	if m.RebootFunc != nil {
+		return m.RebootFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Refresh records the call and returns the results of RefreshFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) Refresh(a0 context.Context, a1 int) (r0 *onappgo.HardwareDevices, r1 *onappgo.Response, r2 error) {
+	m.record("Refresh", a0, a1)
+	if m.RefreshFunc != nil {
+		return m.RefreshFunc(a0, a1)
+	}
+	return
+}
+
+// Attach records the call and returns the results of AttachFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) Attach(a0 context.Context, a1 int, a2 map[string]interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Attach", a0, a1, a2)
+	if m.AttachFunc != nil {
+		return m.AttachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// GetIntegratedStorageSettings records the call and returns the results of GetIntegratedStorageSettingsFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) GetIntegratedStorageSettings(a0 context.Context, a1 int) (r0 *onappgo.IntegratedStorageSettings, r1 *onappgo.Response, r2 error) {
+	m.record("GetIntegratedStorageSettings", a0, a1)
+	if m.GetIntegratedStorageSettingsFunc != nil {
+		return m.GetIntegratedStorageSettingsFunc(a0, a1)
+	}
+	return
+}
+
+// EditIntegratedStorageSettings records the call and returns the results of EditIntegratedStorageSettingsFunc, zero values
+// if it is nil.
+func (m *HypervisorsService) EditIntegratedStorageSettings(a0 context.Context, a1 int, a2 *onappgo.IntegratedStorageSettings) (r0 *onappgo.Response, r1 error) {
+	m.record("EditIntegratedStorageSettings", a0, a1, a2)
+	if m.EditIntegratedStorageSettingsFunc != nil {
+		return m.EditIntegratedStorageSettingsFunc(a0, a1, a2)
+	}
+	return
+}
+
+// IPAddressesService is a mock of onappgo.IPAddressesService.
+type IPAddressesService struct {
+	Recorder
+
+	ListFunc func(context.Context, int, *onappgo.ListOptions) ([]onappgo.IPAddressJoin, *onappgo.Response, error)
+}
+
+var _ onappgo.IPAddressesService = &IPAddressesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *IPAddressesService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.IPAddressJoin, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// IPNetsService is a mock of onappgo.IPNetsService.
+type IPNetsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, *onappgo.ListOptions) ([]onappgo.IPNet, *onappgo.Response, error)
+	GetFunc    func(context.Context, int, int) (*onappgo.IPNet, *onappgo.Response, error)
+	CreateFunc func(context.Context, int, *onappgo.IPNetCreateRequest) (*onappgo.IPNet, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, int, *onappgo.IPNetEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.IPNetsService = &IPNetsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *IPNetsService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.IPNet, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *IPNetsService) Get(a0 context.Context, a1 int, a2 int) (r0 *onappgo.IPNet, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *IPNetsService) Create(a0 context.Context, a1 int, a2 *onappgo.IPNetCreateRequest) (r0 *onappgo.IPNet, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1, a2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *IPNetsService) Delete(a0 context.Context, a1 int, a2 int, a3 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2, a3)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *IPNetsService) Edit(a0 context.Context, a1 int, a2 int, a3 *onappgo.IPNetEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2, a3)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// IPRangesService is a mock of onappgo.IPRangesService.
+type IPRangesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, int, *onappgo.ListOptions) ([]onappgo.IPRange, *onappgo.Response, error)
+	GetFunc    func(context.Context, int, int, int) (*onappgo.IPRange, *onappgo.Response, error)
+	CreateFunc func(context.Context, int, int, *onappgo.IPRangeCreateRequest) (*onappgo.IPRange, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, int, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, int, int, *onappgo.IPRangeCreateRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.IPRangesService = &IPRangesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *IPRangesService) List(a0 context.Context, a1 int, a2 int, a3 *onappgo.ListOptions) (r0 []onappgo.IPRange, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2, a3)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *IPRangesService) Get(a0 context.Context, a1 int, a2 int, a3 int) (r0 *onappgo.IPRange, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2, a3)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *IPRangesService) Create(a0 context.Context, a1 int, a2 int, a3 *onappgo.IPRangeCreateRequest) (r0 *onappgo.IPRange, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1, a2, a3)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *IPRangesService) Delete(a0 context.Context, a1 int, a2 int, a3 int, a4 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2, a3, a4)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2, a3, a4)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *IPRangesService) Edit(a0 context.Context, a1 int, a2 int, a3 int, a4 *onappgo.IPRangeCreateRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2, a3, a4)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2, a3, a4)
+	}
+	return
+}
+
+// ImageTemplateGroupsService is a mock of onappgo.ImageTemplateGroupsService.
+type ImageTemplateGroupsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.ImageTemplateGroup, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.ImageTemplateGroup, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.ImageTemplateGroupCreateRequest) (*onappgo.ImageTemplateGroup, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.ImageTemplateGroupEditRequest) (*onappgo.Response, error)
+	AttachFunc func(context.Context, int, *onappgo.ImageTemplateGroupAttachRequest) (*onappgo.ImageTemplateGroup, *onappgo.Response, error)
+	DetachFunc func(context.Context, int, int) (*onappgo.Response, error)
+}
+
+var _ onappgo.ImageTemplateGroupsService = &ImageTemplateGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *ImageTemplateGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.ImageTemplateGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *ImageTemplateGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.ImageTemplateGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *ImageTemplateGroupsService) Create(a0 context.Context, a1 *onappgo.ImageTemplateGroupCreateRequest) (r0 *onappgo.ImageTemplateGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *ImageTemplateGroupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *ImageTemplateGroupsService) Edit(a0 context.Context, a1 int, a2 *onappgo.ImageTemplateGroupEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Attach records the call and returns the results of AttachFunc, zero values
+// if it is nil.
+func (m *ImageTemplateGroupsService) Attach(a0 context.Context, a1 int, a2 *onappgo.ImageTemplateGroupAttachRequest) (r0 *onappgo.ImageTemplateGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Attach", a0, a1, a2)
+	if m.AttachFunc != nil {
+		return m.AttachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Detach records the call and returns the results of DetachFunc, zero values
+// if it is nil.
+func (m *ImageTemplateGroupsService) Detach(a0 context.Context, a1 int, a2 int) (r0 *onappgo.Response, r1 error) {
+	m.record("Detach", a0, a1, a2)
+	if m.DetachFunc != nil {
+		return m.DetachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ImageTemplatesService is a mock of onappgo.ImageTemplatesService.
+type ImageTemplatesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.ImageTemplate, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.ImageTemplate, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.ImageTemplateCreateRequest) (*onappgo.ImageTemplate, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.ImageTemplateEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.ImageTemplatesService = &ImageTemplatesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *ImageTemplatesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.ImageTemplate, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *ImageTemplatesService) Get(a0 context.Context, a1 int) (r0 *onappgo.ImageTemplate, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *ImageTemplatesService) Create(a0 context.Context, a1 *onappgo.ImageTemplateCreateRequest) (r0 *onappgo.ImageTemplate, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *ImageTemplatesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *ImageTemplatesService) Edit(a0 context.Context, a1 int, a2 *onappgo.ImageTemplateEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// InstancePackagesService is a mock of onappgo.InstancePackagesService.
+type InstancePackagesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.InstancePackage, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.InstancePackage, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.InstancePackageCreateRequest) (*onappgo.InstancePackage, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.InstancePackageEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.InstancePackagesService = &InstancePackagesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *InstancePackagesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.InstancePackage, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *InstancePackagesService) Get(a0 context.Context, a1 int) (r0 *onappgo.InstancePackage, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *InstancePackagesService) Create(a0 context.Context, a1 *onappgo.InstancePackageCreateRequest) (r0 *onappgo.InstancePackage, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *InstancePackagesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *InstancePackagesService) Edit(a0 context.Context, a1 int, a2 *onappgo.InstancePackageEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// IntegratedDataStoresService is a mock of onappgo.IntegratedDataStoresService.
+type IntegratedDataStoresService struct {
+	Recorder
+
+	ListFunc         func(context.Context, int, *onappgo.ListOptions) ([]onappgo.IntegratedDataStores, *onappgo.Response, error)
+	GetFunc          func(context.Context, int, string) (*onappgo.IntegratedDataStores, *onappgo.Response, error)
+	CreateFunc       func(context.Context, int, *onappgo.IntegratedDataStoreCreateRequest) (*onappgo.IntegratedDataStores, *onappgo.Response, error)
+	DeleteFunc       func(context.Context, int, string, interface{}) (*onappgo.Response, error)
+	EditFunc         func(context.Context, int, string, *onappgo.IntegratedDataStoresEditRequest) (*onappgo.Response, error)
+	StorageNodesFunc func(context.Context, int) (*onappgo.StorageNodes, *onappgo.Response, error)
+	BackendNodesFunc func(context.Context, int) (*onappgo.BackendNodes, *onappgo.Response, error)
+}
+
+var _ onappgo.IntegratedDataStoresService = &IntegratedDataStoresService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *IntegratedDataStoresService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.IntegratedDataStores, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *IntegratedDataStoresService) Get(a0 context.Context, a1 int, a2 string) (r0 *onappgo.IntegratedDataStores, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *IntegratedDataStoresService) Create(a0 context.Context, a1 int, a2 *onappgo.IntegratedDataStoreCreateRequest) (r0 *onappgo.IntegratedDataStores, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1, a2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *IntegratedDataStoresService) Delete(a0 context.Context, a1 int, a2 string, a3 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2, a3)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *IntegratedDataStoresService) Edit(a0 context.Context, a1 int, a2 string, a3 *onappgo.IntegratedDataStoresEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2, a3)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// StorageNodes records the call and returns the results of StorageNodesFunc, zero values
+// if it is nil.
+func (m *IntegratedDataStoresService) StorageNodes(a0 context.Context, a1 int) (r0 *onappgo.StorageNodes, r1 *onappgo.Response, r2 error) {
+	m.record("StorageNodes", a0, a1)
+	if m.StorageNodesFunc != nil {
+		return m.StorageNodesFunc(a0, a1)
+	}
+	return
+}
+
+// BackendNodes records the call and returns the results of BackendNodesFunc, zero values
+// if it is nil.
+func (m *IntegratedDataStoresService) BackendNodes(a0 context.Context, a1 int) (r0 *onappgo.BackendNodes, r1 *onappgo.Response, r2 error) {
+	m.record("BackendNodes", a0, a1)
+	if m.BackendNodesFunc != nil {
+		return m.BackendNodesFunc(a0, a1)
+	}
+	return
+}
+
+// LicensesService is a mock of onappgo.LicensesService.
+type LicensesService struct {
+	Recorder
+
+	GetFunc  func(context.Context) (*onappgo.License, *onappgo.Response, error)
+	EditFunc func(context.Context, *onappgo.LicenseEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.LicensesService = &LicensesService{}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *LicensesService) Get(a0 context.Context) (r0 *onappgo.License, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *LicensesService) Edit(a0 context.Context, a1 *onappgo.LicenseEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1)
+	}
+	return
+}
+
+// LocationGroupsService is a mock of onappgo.LocationGroupsService.
+type LocationGroupsService struct {
+	Recorder
+
+	ListFunc    func(context.Context, *onappgo.ListOptions) ([]onappgo.LocationGroup, *onappgo.Response, error)
+	GetFunc     func(context.Context, int) (*onappgo.LocationGroup, *onappgo.Response, error)
+	RefreshFunc func(context.Context) (*onappgo.Response, error)
+}
+
+var _ onappgo.LocationGroupsService = &LocationGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *LocationGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.LocationGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *LocationGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.LocationGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Refresh records the call and returns the results of RefreshFunc, zero values
+// if it is nil.
+func (m *LocationGroupsService) Refresh(a0 context.Context) (r0 *onappgo.Response, r1 error) {
+	m.record("Refresh", a0)
+	if m.RefreshFunc != nil {
+		return m.RefreshFunc(a0)
+	}
+	return
+}
+
+// NetworkGroupsService is a mock of onappgo.NetworkGroupsService.
+type NetworkGroupsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.NetworkGroup, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.NetworkGroup, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.NetworkGroupCreateRequest) (*onappgo.NetworkGroup, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.NetworkGroupEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.NetworkGroupsService = &NetworkGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *NetworkGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.NetworkGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *NetworkGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.NetworkGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *NetworkGroupsService) Create(a0 context.Context, a1 *onappgo.NetworkGroupCreateRequest) (r0 *onappgo.NetworkGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *NetworkGroupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *NetworkGroupsService) Edit(a0 context.Context, a1 int, a2 *onappgo.NetworkGroupEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// NetworkInterfacesService is a mock of onappgo.NetworkInterfacesService.
+type NetworkInterfacesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, *onappgo.ListOptions) ([]onappgo.NetworkInterface, *onappgo.Response, error)
+	GetFunc    func(context.Context, int, int) (*onappgo.NetworkInterface, *onappgo.Response, error)
+	CreateFunc func(context.Context, int, *onappgo.NetworkInterfaceCreateRequest) (*onappgo.NetworkInterface, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, int, *onappgo.NetworkInterfaceEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.NetworkInterfacesService = &NetworkInterfacesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *NetworkInterfacesService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.NetworkInterface, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *NetworkInterfacesService) Get(a0 context.Context, a1 int, a2 int) (r0 *onappgo.NetworkInterface, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *NetworkInterfacesService) Create(a0 context.Context, a1 int, a2 *onappgo.NetworkInterfaceCreateRequest) (r0 *onappgo.NetworkInterface, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1, a2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *NetworkInterfacesService) Delete(a0 context.Context, a1 int, a2 int, a3 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2, a3)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *NetworkInterfacesService) Edit(a0 context.Context, a1 int, a2 int, a3 *onappgo.NetworkInterfaceEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2, a3)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// NetworkJoinsService is a mock of onappgo.NetworkJoinsService.
+type NetworkJoinsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.NetworkJoinCreateRequest, *onappgo.ListOptions) ([]onappgo.NetworkJoin, *onappgo.Response, error)
+	GetFunc    func(context.Context, string, int, int) (*onappgo.NetworkJoin, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.NetworkJoinCreateRequest) (*onappgo.NetworkJoin, *onappgo.Response, error)
+	DeleteFunc func(context.Context, *onappgo.NetworkJoinDeleteRequest, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.NetworkJoinsService = &NetworkJoinsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *NetworkJoinsService) List(a0 context.Context, a1 *onappgo.NetworkJoinCreateRequest, a2 *onappgo.ListOptions) (r0 []onappgo.NetworkJoin, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *NetworkJoinsService) Get(a0 context.Context, a1 string, a2 int, a3 int) (r0 *onappgo.NetworkJoin, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2, a3)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *NetworkJoinsService) Create(a0 context.Context, a1 *onappgo.NetworkJoinCreateRequest) (r0 *onappgo.NetworkJoin, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *NetworkJoinsService) Delete(a0 context.Context, a1 *onappgo.NetworkJoinDeleteRequest, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// NetworksService is a mock of onappgo.NetworksService.
+type NetworksService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.Network, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.Network, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.NetworkCreateRequest) (*onappgo.Network, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.NetworkEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.NetworksService = &NetworksService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *NetworksService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Network, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *NetworksService) Get(a0 context.Context, a1 int) (r0 *onappgo.Network, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *NetworksService) Create(a0 context.Context, a1 *onappgo.NetworkCreateRequest) (r0 *onappgo.Network, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *NetworksService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *NetworksService) Edit(a0 context.Context, a1 int, a2 *onappgo.NetworkEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// RateCardsService is a mock of onappgo.RateCardsService.
+type RateCardsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, *onappgo.ListOptions) ([]onappgo.RateCard, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.RateCardCreateRequest) (*onappgo.RateCard, *onappgo.Response, error)
+	DeleteFunc func(context.Context, *onappgo.RateCardDeleteRequest, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.RateCardsService = &RateCardsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *RateCardsService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.RateCard, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *RateCardsService) Create(a0 context.Context, a1 *onappgo.RateCardCreateRequest) (r0 *onappgo.RateCard, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *RateCardsService) Delete(a0 context.Context, a1 *onappgo.RateCardDeleteRequest, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// RecipeGroupsService is a mock of onappgo.RecipeGroupsService.
+type RecipeGroupsService struct {
+	Recorder
+
+	ListFunc    func(context.Context, *onappgo.ListOptions) ([]onappgo.RecipeGroup, *onappgo.Response, error)
+	GetFunc     func(context.Context, int) (*onappgo.RecipeGroup, *onappgo.Response, error)
+	CreateFunc  func(context.Context, *onappgo.RecipeGroupCreateRequest) (*onappgo.RecipeGroup, *onappgo.Response, error)
+	DeleteFunc  func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc    func(context.Context, int, *onappgo.RecipeGroupEditRequest) (*onappgo.Response, error)
+	AttachFunc  func(context.Context, int, *onappgo.RecipeGroupAttachRequest) (*onappgo.Response, error)
+	DetachFunc  func(context.Context, int, int) (*onappgo.Response, error)
+	RecipesFunc func(context.Context, int, *onappgo.ListOptions) ([]onappgo.Recipe, *onappgo.Response, error)
+}
+
+var _ onappgo.RecipeGroupsService = &RecipeGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.RecipeGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.RecipeGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) Create(a0 context.Context, a1 *onappgo.RecipeGroupCreateRequest) (r0 *onappgo.RecipeGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) Edit(a0 context.Context, a1 int, a2 *onappgo.RecipeGroupEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Attach records the call and returns the results of AttachFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) Attach(a0 context.Context, a1 int, a2 *onappgo.RecipeGroupAttachRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Attach", a0, a1, a2)
+	if m.AttachFunc != nil {
+		return m.AttachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Detach records the call and returns the results of DetachFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) Detach(a0 context.Context, a1 int, a2 int) (r0 *onappgo.Response, r1 error) {
+	m.record("Detach", a0, a1, a2)
+	if m.DetachFunc != nil {
+		return m.DetachFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Recipes records the call and returns the results of RecipesFunc, zero values
+// if it is nil.
+func (m *RecipeGroupsService) Recipes(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.Recipe, r1 *onappgo.Response, r2 error) {
+	m.record("Recipes", a0, a1, a2)
+	if m.RecipesFunc != nil {
+		return m.RecipesFunc(a0, a1, a2)
+	}
+	return
+}
+
+// RecipeJoinsService is a mock of onappgo.RecipeJoinsService.
+type RecipeJoinsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.RecipeJoinCreateRequest, *onappgo.ListOptions) (map[string]interface{}, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.RecipeJoinCreateRequest) (*onappgo.RecipeJoin, *onappgo.Response, error)
+	DeleteFunc func(context.Context, *onappgo.RecipeJoinDeleteRequest, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.RecipeJoinsService = &RecipeJoinsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *RecipeJoinsService) List(a0 context.Context, a1 *onappgo.RecipeJoinCreateRequest, a2 *onappgo.ListOptions) (r0 map[string]interface{}, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *RecipeJoinsService) Create(a0 context.Context, a1 *onappgo.RecipeJoinCreateRequest) (r0 *onappgo.RecipeJoin, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *RecipeJoinsService) Delete(a0 context.Context, a1 *onappgo.RecipeJoinDeleteRequest, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// RecipeStepsService is a mock of onappgo.RecipeStepsService.
+type RecipeStepsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, *onappgo.ListOptions) ([]onappgo.RecipeStep, *onappgo.Response, error)
+	GetFunc    func(context.Context, int, int) (*onappgo.RecipeStep, *onappgo.Response, error)
+	CreateFunc func(context.Context, int, *onappgo.RecipeStepCreateRequest) (*onappgo.RecipeStep, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, int, interface{}) (*onappgo.Response, error)
+	SwapFunc   func(context.Context, int, int, int, interface{}) (*onappgo.Response, error)
+}
+
+var _ onappgo.RecipeStepsService = &RecipeStepsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *RecipeStepsService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.RecipeStep, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *RecipeStepsService) Get(a0 context.Context, a1 int, a2 int) (r0 *onappgo.RecipeStep, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *RecipeStepsService) Create(a0 context.Context, a1 int, a2 *onappgo.RecipeStepCreateRequest) (r0 *onappgo.RecipeStep, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1, a2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *RecipeStepsService) Delete(a0 context.Context, a1 int, a2 int, a3 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2, a3)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Swap records the call and returns the results of SwapFunc, zero values
+// if it is nil.
+func (m *RecipeStepsService) Swap(a0 context.Context, a1 int, a2 int, a3 int, a4 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Swap", a0, a1, a2, a3, a4)
+	if m.SwapFunc != nil {
+		return m.SwapFunc(a0, a1, a2, a3, a4)
+	}
+	return
+}
+
+// RecipesService is a mock of onappgo.RecipesService.
+type RecipesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.Recipe, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.Recipe, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.RecipeCreateRequest) (*onappgo.Recipe, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.RecipeCreateRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.RecipesService = &RecipesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *RecipesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Recipe, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *RecipesService) Get(a0 context.Context, a1 int) (r0 *onappgo.Recipe, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *RecipesService) Create(a0 context.Context, a1 *onappgo.RecipeCreateRequest) (r0 *onappgo.Recipe, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *RecipesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *RecipesService) Edit(a0 context.Context, a1 int, a2 *onappgo.RecipeCreateRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// RemoteTemplatesService is a mock of onappgo.RemoteTemplatesService.
+type RemoteTemplatesService struct {
+	Recorder
+
+	ListFunc func(context.Context, *onappgo.ListOptions) ([]onappgo.RemoteTemplate, *onappgo.Response, error)
+}
+
+var _ onappgo.RemoteTemplatesService = &RemoteTemplatesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *RemoteTemplatesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.RemoteTemplate, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// ResolversService is a mock of onappgo.ResolversService.
+type ResolversService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.Resolver, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.Resolver, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.ResolverCreateRequest) (*onappgo.Resolver, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.ResolverCreateRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.ResolversService = &ResolversService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *ResolversService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Resolver, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *ResolversService) Get(a0 context.Context, a1 int) (r0 *onappgo.Resolver, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *ResolversService) Create(a0 context.Context, a1 *onappgo.ResolverCreateRequest) (r0 *onappgo.Resolver, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *ResolversService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *ResolversService) Edit(a0 context.Context, a1 int, a2 *onappgo.ResolverCreateRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// RolesService is a mock of onappgo.RolesService.
+type RolesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.Role, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.Role, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.RoleCreateRequest) (*onappgo.Role, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.RoleCreateRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.RolesService = &RolesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *RolesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Role, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *RolesService) Get(a0 context.Context, a1 int) (r0 *onappgo.Role, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *RolesService) Create(a0 context.Context, a1 *onappgo.RoleCreateRequest) (r0 *onappgo.Role, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *RolesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *RolesService) Edit(a0 context.Context, a1 int, a2 *onappgo.RoleCreateRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// SSHKeysService is a mock of onappgo.SSHKeysService.
+type SSHKeysService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.SSHKey, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.SSHKey, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.SSHKeyCreateRequest) (*onappgo.SSHKey, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.SSHKeyEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.SSHKeysService = &SSHKeysService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *SSHKeysService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.SSHKey, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *SSHKeysService) Get(a0 context.Context, a1 int) (r0 *onappgo.SSHKey, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *SSHKeysService) Create(a0 context.Context, a1 *onappgo.SSHKeyCreateRequest) (r0 *onappgo.SSHKey, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *SSHKeysService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *SSHKeysService) Edit(a0 context.Context, a1 int, a2 *onappgo.SSHKeyEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// SoftwareLicensesService is a mock of onappgo.SoftwareLicensesService.
+type SoftwareLicensesService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.SoftwareLicense, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.SoftwareLicense, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.SoftwareLicenseCreateRequest) (*onappgo.SoftwareLicense, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.SoftwareLicenseEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.SoftwareLicensesService = &SoftwareLicensesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *SoftwareLicensesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.SoftwareLicense, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *SoftwareLicensesService) Get(a0 context.Context, a1 int) (r0 *onappgo.SoftwareLicense, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *SoftwareLicensesService) Create(a0 context.Context, a1 *onappgo.SoftwareLicenseCreateRequest) (r0 *onappgo.SoftwareLicense, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *SoftwareLicensesService) Delete(a0 context.Context, a1 int) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *SoftwareLicensesService) Edit(a0 context.Context, a1 int, a2 *onappgo.SoftwareLicenseEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// TransactionsService is a mock of onappgo.TransactionsService.
+type TransactionsService struct {
+	Recorder
+
+	ListFunc        func(context.Context, *onappgo.ListOptions) ([]onappgo.Transaction, *onappgo.Response, error)
+	GetFunc         func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	FilterFunc      func(context.Context, *onappgo.TransactionListOptions) ([]onappgo.Transaction, *onappgo.Response, error)
+	GetByFilterFunc func(context.Context, *onappgo.TransactionListOptions) (*onappgo.Transaction, *onappgo.Response, error)
+	ListByGroupFunc func(context.Context, *onappgo.TransactionListOptions, bool) ([]onappgo.Transaction, *onappgo.Response, error)
+	WaitFunc        func(context.Context, int, *onappgo.TransactionWaitOptions) (*onappgo.Transaction, *onappgo.Response, error)
+	LastIDFunc      func(context.Context, *onappgo.TransactionListOptions) (int, *onappgo.Response, error)
+	WaitAfterFunc   func(context.Context, *onappgo.TransactionListOptions, int, *onappgo.TransactionWaitOptions) (*onappgo.Response, error)
+}
+
+var _ onappgo.TransactionsService = &TransactionsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *TransactionsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *TransactionsService) Get(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Filter records the call and returns the results of FilterFunc, zero values
+// if it is nil.
+func (m *TransactionsService) Filter(a0 context.Context, a1 *onappgo.TransactionListOptions) (r0 []onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Filter", a0, a1)
+	if m.FilterFunc != nil {
+		return m.FilterFunc(a0, a1)
+	}
+	return
+}
+
+// GetByFilter records the call and returns the results of GetByFilterFunc, zero values
+// if it is nil.
+func (m *TransactionsService) GetByFilter(a0 context.Context, a1 *onappgo.TransactionListOptions) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("GetByFilter", a0, a1)
+	if m.GetByFilterFunc != nil {
+		return m.GetByFilterFunc(a0, a1)
+	}
+	return
+}
+
+// ListByGroup records the call and returns the results of ListByGroupFunc, zero values
+// if it is nil.
+func (m *TransactionsService) ListByGroup(a0 context.Context, a1 *onappgo.TransactionListOptions, a2 bool) (r0 []onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("ListByGroup", a0, a1, a2)
+	if m.ListByGroupFunc != nil {
+		return m.ListByGroupFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Wait records the call and returns the results of WaitFunc, zero values
+// if it is nil.
+func (m *TransactionsService) Wait(a0 context.Context, a1 int, a2 *onappgo.TransactionWaitOptions) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Wait", a0, a1, a2)
+	if m.WaitFunc != nil {
+		return m.WaitFunc(a0, a1, a2)
+	}
+	return
+}
+
+// LastID records the call and returns the results of LastIDFunc, zero values
+// if it is nil.
+func (m *TransactionsService) LastID(a0 context.Context, a1 *onappgo.TransactionListOptions) (r0 int, r1 *onappgo.Response, r2 error) {
+	m.record("LastID", a0, a1)
+	if m.LastIDFunc != nil {
+		return m.LastIDFunc(a0, a1)
+	}
+	return
+}
+
+// WaitAfter records the call and returns the results of WaitAfterFunc, zero values
+// if it is nil.
+func (m *TransactionsService) WaitAfter(a0 context.Context, a1 *onappgo.TransactionListOptions, a2 int, a3 *onappgo.TransactionWaitOptions) (r0 *onappgo.Response, r1 error) {
+	m.record("WaitAfter", a0, a1, a2, a3)
+	if m.WaitAfterFunc != nil {
+		return m.WaitAfterFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// UserGroupsService is a mock of onappgo.UserGroupsService.
+type UserGroupsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, *onappgo.ListOptions) ([]onappgo.UserGroup, *onappgo.Response, error)
+	GetFunc    func(context.Context, int) (*onappgo.UserGroup, *onappgo.Response, error)
+	CreateFunc func(context.Context, *onappgo.UserGroupCreateRequest) (*onappgo.UserGroup, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, *onappgo.UserGroupEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.UserGroupsService = &UserGroupsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *UserGroupsService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.UserGroup, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *UserGroupsService) Get(a0 context.Context, a1 int) (r0 *onappgo.UserGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *UserGroupsService) Create(a0 context.Context, a1 *onappgo.UserGroupCreateRequest) (r0 *onappgo.UserGroup, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *UserGroupsService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *UserGroupsService) Edit(a0 context.Context, a1 int, a2 *onappgo.UserGroupEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// UserWhiteListsService is a mock of onappgo.UserWhiteListsService.
+type UserWhiteListsService struct {
+	Recorder
+
+	ListFunc   func(context.Context, int, *onappgo.ListOptions) ([]onappgo.UserWhiteList, *onappgo.Response, error)
+	GetFunc    func(context.Context, int, int) (*onappgo.UserWhiteList, *onappgo.Response, error)
+	CreateFunc func(context.Context, int, *onappgo.UserWhiteListCreateRequest) (*onappgo.UserWhiteList, *onappgo.Response, error)
+	DeleteFunc func(context.Context, int, int, interface{}) (*onappgo.Response, error)
+	EditFunc   func(context.Context, int, int, *onappgo.UserWhiteListEditRequest) (*onappgo.Response, error)
+}
+
+var _ onappgo.UserWhiteListsService = &UserWhiteListsService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *UserWhiteListsService) List(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.UserWhiteList, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1, a2)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *UserWhiteListsService) Get(a0 context.Context, a1 int, a2 int) (r0 *onappgo.UserWhiteList, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *UserWhiteListsService) Create(a0 context.Context, a1 int, a2 *onappgo.UserWhiteListCreateRequest) (r0 *onappgo.UserWhiteList, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1, a2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *UserWhiteListsService) Delete(a0 context.Context, a1 int, a2 int, a3 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2, a3)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *UserWhiteListsService) Edit(a0 context.Context, a1 int, a2 int, a3 *onappgo.UserWhiteListEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2, a3)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// UsersService is a mock of onappgo.UsersService.
+type UsersService struct {
+	Recorder
+
+	ListFunc          func(context.Context, *onappgo.ListOptions) ([]onappgo.User, *onappgo.Response, error)
+	GetFunc           func(context.Context, int) (*onappgo.User, *onappgo.Response, error)
+	CreateFunc        func(context.Context, *onappgo.UserCreateRequest) (*onappgo.User, *onappgo.Response, error)
+	DeleteFunc        func(context.Context, int, interface{}) (*onappgo.Response, error)
+	EditFunc          func(context.Context, int, *onappgo.UserEditRequest) (*onappgo.Response, error)
+	MakeNewAPIKeyFunc func(context.Context, int) (string, *onappgo.Response, error)
+}
+
+var _ onappgo.UsersService = &UsersService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *UsersService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.User, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *UsersService) Get(a0 context.Context, a1 int) (r0 *onappgo.User, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *UsersService) Create(a0 context.Context, a1 *onappgo.UserCreateRequest) (r0 *onappgo.User, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *UsersService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Response, r1 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *UsersService) Edit(a0 context.Context, a1 int, a2 *onappgo.UserEditRequest) (r0 *onappgo.Response, r1 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// MakeNewAPIKey records the call and returns the results of MakeNewAPIKeyFunc, zero values
+// if it is nil.
+func (m *UsersService) MakeNewAPIKey(a0 context.Context, a1 int) (r0 string, r1 *onappgo.Response, r2 error) {
+	m.record("MakeNewAPIKey", a0, a1)
+	if m.MakeNewAPIKeyFunc != nil {
+		return m.MakeNewAPIKeyFunc(a0, a1)
+	}
+	return
+}
+
+// VirtualMachineActionsService is a mock of onappgo.VirtualMachineActionsService.
+type VirtualMachineActionsService struct {
+	Recorder
+
+	ShutdownFunc          func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	StopFunc              func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	StartupFunc           func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	UnlockFunc            func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	RebootFunc            func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	SuspendFunc           func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	UnsuspendFunc         func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	RebuildFunc           func(context.Context, int, *onappgo.VirtualMachineRebuildRequest) (*onappgo.Transaction, *onappgo.Response, error)
+	RebootInRecoveryFunc  func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+	BootFromISOFunc       func(context.Context, int, *onappgo.VirtualMachineISOBootRequest) (*onappgo.Transaction, *onappgo.Response, error)
+	SegregateFunc         func(context.Context, int, int) (*onappgo.Transaction, *onappgo.Response, error)
+	ChangeOwnerFunc       func(context.Context, int, int) (*onappgo.Transaction, *onappgo.Response, error)
+	SetVIPFunc            func(context.Context, int, bool) (*onappgo.Transaction, *onappgo.Response, error)
+	MigrateFunc           func(context.Context, int, *onappgo.VirtualMachineMigrateRequest) (*onappgo.VirtualMachineMigration, *onappgo.Response, error)
+	CanHotMigrateFunc     func(context.Context, int, *onappgo.VirtualMachineMigrateRequest) ([]string, *onappgo.Response, error)
+	ResetPasswordFunc     func(context.Context, int, string, string) (*onappgo.Transaction, *onappgo.Response, error)
+	FQDNFunc              func(context.Context, int, string, string) (*onappgo.Transaction, *onappgo.Response, error)
+	RebuildNetworkFunc    func(context.Context, int, interface{}) (*onappgo.Transaction, *onappgo.Response, error)
+	AssignIPAddressFunc   func(context.Context, int, interface{}) (*onappgo.Transaction, *onappgo.Response, error)
+	UnAssignIPAddressFunc func(context.Context, int, int, interface{}) (*onappgo.Transaction, *onappgo.Response, error)
+	ListIPAddressesFunc   func(context.Context, int) (*onappgo.Transaction, *onappgo.Response, error)
+}
+
+var _ onappgo.VirtualMachineActionsService = &VirtualMachineActionsService{}
+
+// Shutdown records the call and returns the results of ShutdownFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Shutdown(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Shutdown", a0, a1)
+	if m.ShutdownFunc != nil {
+		return m.ShutdownFunc(a0, a1)
+	}
+	return
+}
+
+// Stop records the call and returns the results of StopFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Stop(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Stop", a0, a1)
+	if m.StopFunc != nil {
+		return m.StopFunc(a0, a1)
+	}
+	return
+}
+
+// Startup records the call and returns the results of StartupFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Startup(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Startup", a0, a1)
+	if m.StartupFunc != nil {
+		return m.StartupFunc(a0, a1)
+	}
+	return
+}
+
+// Unlock records the call and returns the results of UnlockFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Unlock(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Unlock", a0, a1)
+	if m.UnlockFunc != nil {
+		return m.UnlockFunc(a0, a1)
+	}
+	return
+}
+
+// Reboot records the call and returns the results of RebootFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Reboot(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Reboot", a0, a1)
+	if m.RebootFunc != nil {
+		return m.RebootFunc(a0, a1)
+	}
+	return
+}
+
+// Suspend records the call and returns the results of SuspendFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Suspend(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Suspend", a0, a1)
+	if m.SuspendFunc != nil {
+		return m.SuspendFunc(a0, a1)
+	}
+	return
+}
+
+// Unsuspend records the call and returns the results of UnsuspendFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Unsuspend(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Unsuspend", a0, a1)
+	if m.UnsuspendFunc != nil {
+		return m.UnsuspendFunc(a0, a1)
+	}
+	return
+}
+
+// Rebuild records the call and returns the results of RebuildFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Rebuild(a0 context.Context, a1 int, a2 *onappgo.VirtualMachineRebuildRequest) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Rebuild", a0, a1, a2)
+	if m.RebuildFunc != nil {
+		return m.RebuildFunc(a0, a1, a2)
+	}
+	return
+}
+
+// RebootInRecovery records the call and returns the results of RebootInRecoveryFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) RebootInRecovery(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("RebootInRecovery", a0, a1)
+	if m.RebootInRecoveryFunc != nil {
+		return m.RebootInRecoveryFunc(a0, a1)
+	}
+	return
+}
+
+// BootFromISO records the call and returns the results of BootFromISOFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) BootFromISO(a0 context.Context, a1 int, a2 *onappgo.VirtualMachineISOBootRequest) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("BootFromISO", a0, a1, a2)
+	if m.BootFromISOFunc != nil {
+		return m.BootFromISOFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Segregate records the call and returns the results of SegregateFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Segregate(a0 context.Context, a1 int, a2 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Segregate", a0, a1, a2)
+	if m.SegregateFunc != nil {
+		return m.SegregateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ChangeOwner records the call and returns the results of ChangeOwnerFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) ChangeOwner(a0 context.Context, a1 int, a2 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("ChangeOwner", a0, a1, a2)
+	if m.ChangeOwnerFunc != nil {
+		return m.ChangeOwnerFunc(a0, a1, a2)
+	}
+	return
+}
+
+// SetVIP records the call and returns the results of SetVIPFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) SetVIP(a0 context.Context, a1 int, a2 bool) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("SetVIP", a0, a1, a2)
+	if m.SetVIPFunc != nil {
+		return m.SetVIPFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Migrate records the call and returns the results of MigrateFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) Migrate(a0 context.Context, a1 int, a2 *onappgo.VirtualMachineMigrateRequest) (r0 *onappgo.VirtualMachineMigration, r1 *onappgo.Response, r2 error) {
+	m.record("Migrate", a0, a1, a2)
+	if m.MigrateFunc != nil {
+		return m.MigrateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// CanHotMigrate records the call and returns the results of CanHotMigrateFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) CanHotMigrate(a0 context.Context, a1 int, a2 *onappgo.VirtualMachineMigrateRequest) (r0 []string, r1 *onappgo.Response, r2 error) {
+	m.record("CanHotMigrate", a0, a1, a2)
+	if m.CanHotMigrateFunc != nil {
+		return m.CanHotMigrateFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ResetPassword records the call and returns the results of ResetPasswordFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) ResetPassword(a0 context.Context, a1 int, a2 string, a3 string) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("ResetPassword", a0, a1, a2, a3)
+	if m.ResetPasswordFunc != nil {
+		return m.ResetPasswordFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// FQDN records the call and returns the results of FQDNFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) FQDN(a0 context.Context, a1 int, a2 string, a3 string) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("FQDN", a0, a1, a2, a3)
+	if m.FQDNFunc != nil {
+		return m.FQDNFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// RebuildNetwork records the call and returns the results of RebuildNetworkFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) RebuildNetwork(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("RebuildNetwork", a0, a1, a2)
+	if m.RebuildNetworkFunc != nil {
+		return m.RebuildNetworkFunc(a0, a1, a2)
+	}
+	return
+}
+
+// AssignIPAddress records the call and returns the results of AssignIPAddressFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) AssignIPAddress(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("AssignIPAddress", a0, a1, a2)
+	if m.AssignIPAddressFunc != nil {
+		return m.AssignIPAddressFunc(a0, a1, a2)
+	}
+	return
+}
+
+// UnAssignIPAddress records the call and returns the results of UnAssignIPAddressFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) UnAssignIPAddress(a0 context.Context, a1 int, a2 int, a3 interface{}) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("UnAssignIPAddress", a0, a1, a2, a3)
+	if m.UnAssignIPAddressFunc != nil {
+		return m.UnAssignIPAddressFunc(a0, a1, a2, a3)
+	}
+	return
+}
+
+// ListIPAddresses records the call and returns the results of ListIPAddressesFunc, zero values
+// if it is nil.
+func (m *VirtualMachineActionsService) ListIPAddresses(a0 context.Context, a1 int) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("ListIPAddresses", a0, a1)
+	if m.ListIPAddressesFunc != nil {
+		return m.ListIPAddressesFunc(a0, a1)
+	}
+	return
+}
+
+// VirtualMachinesService is a mock of onappgo.VirtualMachinesService.
+type VirtualMachinesService struct {
+	Recorder
+
+	ListFunc                  func(context.Context, *onappgo.ListOptions) ([]onappgo.VirtualMachine, *onappgo.Response, error)
+	GetFunc                   func(context.Context, int) (*onappgo.VirtualMachine, *onappgo.Response, error)
+	CreateFunc                func(context.Context, *onappgo.VirtualMachineCreateRequest) (*onappgo.VirtualMachine, *onappgo.Response, error)
+	DeleteFunc                func(context.Context, int, interface{}) (*onappgo.Transaction, *onappgo.Response, error)
+	EditFunc                  func(context.Context, int, *onappgo.VirtualMachineEditRequest) (*onappgo.VirtualMachineEdit, *onappgo.Response, error)
+	CreateAndWaitFunc         func(context.Context, *onappgo.VirtualMachineCreateRequest, *onappgo.VirtualMachineBuildOptions) (*onappgo.VirtualMachineBuild, *onappgo.Response, error)
+	BackupsFunc               func(context.Context, int, *onappgo.ListOptions) ([]onappgo.Backup, *onappgo.Response, error)
+	TransactionsFunc          func(context.Context, int, *onappgo.ListOptions) ([]onappgo.Transaction, *onappgo.Response, error)
+	DisksFunc                 func(context.Context, int, *onappgo.ListOptions) ([]onappgo.Disk, *onappgo.Response, error)
+	ListNetworkInterfacesFunc func(context.Context, int, *onappgo.ListOptions) ([]onappgo.NetworkInterface, *onappgo.Response, error)
+	ListFirewallRulesFunc     func(context.Context, int, *onappgo.ListOptions) ([]onappgo.FirewallRule, *onappgo.Response, error)
+}
+
+var _ onappgo.VirtualMachinesService = &VirtualMachinesService{}
+
+// List records the call and returns the results of ListFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) List(a0 context.Context, a1 *onappgo.ListOptions) (r0 []onappgo.VirtualMachine, r1 *onappgo.Response, r2 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc != nil {
+		return m.ListFunc(a0, a1)
+	}
+	return
+}
+
+// Get records the call and returns the results of GetFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) Get(a0 context.Context, a1 int) (r0 *onappgo.VirtualMachine, r1 *onappgo.Response, r2 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc != nil {
+		return m.GetFunc(a0, a1)
+	}
+	return
+}
+
+// Create records the call and returns the results of CreateFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) Create(a0 context.Context, a1 *onappgo.VirtualMachineCreateRequest) (r0 *onappgo.VirtualMachine, r1 *onappgo.Response, r2 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(a0, a1)
+	}
+	return
+}
+
+// Delete records the call and returns the results of DeleteFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) Delete(a0 context.Context, a1 int, a2 interface{}) (r0 *onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Edit records the call and returns the results of EditFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) Edit(a0 context.Context, a1 int, a2 *onappgo.VirtualMachineEditRequest) (r0 *onappgo.VirtualMachineEdit, r1 *onappgo.Response, r2 error) {
+	m.record("Edit", a0, a1, a2)
+	if m.EditFunc != nil {
+		return m.EditFunc(a0, a1, a2)
+	}
+	return
+}
+
+// CreateAndWait records the call and returns the results of CreateAndWaitFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) CreateAndWait(a0 context.Context, a1 *onappgo.VirtualMachineCreateRequest, a2 *onappgo.VirtualMachineBuildOptions) (r0 *onappgo.VirtualMachineBuild, r1 *onappgo.Response, r2 error) {
+	m.record("CreateAndWait", a0, a1, a2)
+	if m.CreateAndWaitFunc != nil {
+		return m.CreateAndWaitFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Backups records the call and returns the results of BackupsFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) Backups(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.Backup, r1 *onappgo.Response, r2 error) {
+	m.record("Backups", a0, a1, a2)
+	if m.BackupsFunc != nil {
+		return m.BackupsFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Transactions records the call and returns the results of TransactionsFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) Transactions(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.Transaction, r1 *onappgo.Response, r2 error) {
+	m.record("Transactions", a0, a1, a2)
+	if m.TransactionsFunc != nil {
+		return m.TransactionsFunc(a0, a1, a2)
+	}
+	return
+}
+
+// Disks records the call and returns the results of DisksFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) Disks(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.Disk, r1 *onappgo.Response, r2 error) {
+	m.record("Disks", a0, a1, a2)
+	if m.DisksFunc != nil {
+		return m.DisksFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ListNetworkInterfaces records the call and returns the results of ListNetworkInterfacesFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) ListNetworkInterfaces(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.NetworkInterface, r1 *onappgo.Response, r2 error) {
+	m.record("ListNetworkInterfaces", a0, a1, a2)
+	if m.ListNetworkInterfacesFunc != nil {
+		return m.ListNetworkInterfacesFunc(a0, a1, a2)
+	}
+	return
+}
+
+// ListFirewallRules records the call and returns the results of ListFirewallRulesFunc, zero values
+// if it is nil.
+func (m *VirtualMachinesService) ListFirewallRules(a0 context.Context, a1 int, a2 *onappgo.ListOptions) (r0 []onappgo.FirewallRule, r1 *onappgo.Response, r2 error) {
+	m.record("ListFirewallRules", a0, a1, a2)
+	if m.ListFirewallRulesFunc != nil {
+		return m.ListFirewallRulesFunc(a0, a1, a2)
+	}
+	return
+}
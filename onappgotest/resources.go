@@ -0,0 +1,278 @@
+package onappgotest
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+func (s *Server) registerRoutes() {
+	// the actions of the virtual machines match any path, so they come last
+	s.transactionRoutes()
+	s.diskRoutes()
+	s.backupRoutes()
+	s.virtualMachineRoutes()
+
+	s.handle(http.MethodGet, "version", func(*request) (int, interface{}) {
+		return http.StatusOK, map[string]string{"version": s.Version}
+	})
+
+	s.crud("settings/networks", &resource{
+		coll:     networks,
+		root:     "network",
+		required: []string{"label"},
+		remove:   s.removeNetwork,
+	})
+
+	s.crud("settings/networks/:id/ip_nets", &resource{
+		coll:     ipNets,
+		root:     "ip_net",
+		parents:  []string{"network"},
+		required: []string{"network_address", "network_mask"},
+		create:   s.createIPNet,
+		remove:   s.removeIPNet,
+	})
+
+	s.crud("settings/networks/:id/ip_nets/:id/ip_ranges", &resource{
+		coll:     ipRanges,
+		root:     "ip_range",
+		parents:  []string{"network", "ip_net"},
+		required: []string{"start_address", "end_address"},
+	})
+
+	s.crud("users", &resource{
+		coll:     users,
+		root:     "user",
+		required: []string{"login", "email"},
+		create:   createUser,
+	})
+	s.handle(http.MethodPost, "users/:id/make_new_api_key", s.makeNewAPIKey)
+
+	s.crud("billing/buckets", &resource{
+		coll:     buckets,
+		root:     "bucket",
+		required: []string{"label"},
+	})
+
+	s.crud("settings/hypervisors", &resource{
+		coll:     hypervisors,
+		root:     "hypervisor",
+		required: []string{"label"},
+		create: func(_ *request, o object) {
+			o["online"] = true
+		},
+	})
+}
+
+// resource is a collection served with the generic handlers, which are done
+// at once without transactions.
+type resource struct {
+	coll string
+	root string
+
+	// parents are the resources of the IDs of the path before the ID of the
+	// object. The objects are stored with "<parent>_id" fields, and sent with
+	// "<parent>": {"id": ID} like the Control Panel does.
+	parents []string
+
+	// required fields of the new objects
+	required []string
+
+	// create sets the fields of a new object, once stored
+	create func(r *request, o object)
+
+	// remove deletes the objects depending on the removed one
+	remove func(o object)
+}
+
+// crud registers the list, create, get, edit and delete endpoints of the
+// resource.
+func (s *Server) crud(path string, res *resource) {
+	item := path + "/:id"
+
+	s.handle(http.MethodGet, path, func(r *request) (int, interface{}) {
+		return list(r, res.root, s.store.where(res.coll, res.childOf(r.ids)))
+	})
+
+	s.handle(http.MethodGet, item, func(r *request) (int, interface{}) {
+		o := s.find(res, r)
+		if o == nil {
+			return notFound()
+		}
+
+		return http.StatusOK, map[string]interface{}{res.root: o}
+	})
+
+	s.handle(http.MethodPost, path, func(r *request) (int, interface{}) {
+		for i, parent := range res.parents {
+			if s.store.get(parent+"s", r.ids[i]) == nil {
+				return notFound()
+			}
+		}
+
+		o := object(r.object(res.root))
+		if errs := required(o, res.required...); errs != nil {
+			return validationFailed(errs)
+		}
+
+		for i, parent := range res.parents {
+			o[parent+"_id"] = r.ids[i]
+			o[parent] = map[string]interface{}{"id": r.ids[i]}
+		}
+
+		s.store.insert(res.coll, o)
+		if res.create != nil {
+			res.create(r, o)
+		}
+
+		return http.StatusCreated, map[string]interface{}{res.root: o}
+	})
+
+	edit := func(r *request) (int, interface{}) {
+		o := s.find(res, r)
+		if o == nil {
+			return notFound()
+		}
+
+		o.merge(r.object(res.root))
+		return http.StatusNoContent, nil
+	}
+	s.handle(http.MethodPut, item, edit)
+	s.handle(http.MethodPatch, item, edit)
+
+	s.handle(http.MethodDelete, item, func(r *request) (int, interface{}) {
+		o := s.find(res, r)
+		if o == nil {
+			return notFound()
+		}
+
+		s.store.delete(res.coll, o.int("id"))
+		if res.remove != nil {
+			res.remove(o)
+		}
+
+		return http.StatusNoContent, nil
+	})
+}
+
+// find returns the object of the path, nil if it doesn't exist or doesn't
+// belong to the parents of the path.
+func (s *Server) find(res *resource, r *request) object {
+	o := s.store.get(res.coll, r.id())
+	if o == nil || !res.childOf(r.ids)(o) {
+		return nil
+	}
+
+	return o
+}
+
+// childOf returns a filter of the objects belonging to the parents of ids.
+func (res *resource) childOf(ids []int) func(object) bool {
+	return func(o object) bool {
+		for i, parent := range res.parents {
+			if o.int(parent+"_id") != ids[i] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// required returns the errors of the missing fields, nil if there are none.
+func required(o object, fields ...string) map[string][]string {
+	var errs map[string][]string
+	for _, field := range fields {
+		switch v := o[field].(type) {
+		case nil:
+		case string:
+			if strings.TrimSpace(v) != "" {
+				continue
+			}
+		case float64:
+			if v != 0 {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if errs == nil {
+			errs = make(map[string][]string)
+		}
+		errs[field] = append(errs[field], "can't be blank")
+	}
+
+	return errs
+}
+
+func (s *Server) removeNetwork(o object) {
+	for _, ipNet := range s.store.where(ipNets, fieldIs("network_id", o.int("id"))) {
+		s.store.delete(ipNets, ipNet.int("id"))
+		s.removeIPNet(ipNet)
+	}
+}
+
+// createIPNet adds the range of all the addresses of the net when asked by
+// add_default_ip_range.
+func (s *Server) createIPNet(r *request, o object) {
+	o["ipv4"] = !strings.Contains(o.string("network_address"), ":")
+	o["enabled"] = true
+
+	if !o.bool("add_default_ip_range") {
+		return
+	}
+	delete(o, "add_default_ip_range")
+
+	prefix, err := netip.ParsePrefix(fmt.Sprintf("%s/%d", o.string("network_address"), o.int("network_mask")))
+	if err != nil {
+		return
+	}
+	prefix = prefix.Masked()
+
+	s.store.insert(ipRanges, object{
+		"start_address":   prefix.Addr().Next().String(),
+		"end_address":     broadcast(prefix).Prev().String(),
+		"default_gateway": o.string("default_gateway"),
+		"ipv4":            o["ipv4"],
+		"network_id":      o.int("network_id"),
+		"ip_net_id":       o.int("id"),
+		"ip_net":          map[string]interface{}{"id": o.int("id")},
+	})
+}
+
+// broadcast returns the last address of the prefix.
+func broadcast(prefix netip.Prefix) netip.Addr {
+	b := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+func (s *Server) removeIPNet(o object) {
+	s.store.deleteWhere(ipRanges, fieldIs("ip_net_id", o.int("id")))
+}
+
+// createUser doesn't keep the password, which is never sent back by the
+// Control Panel.
+func createUser(r *request, o object) {
+	delete(o, "password")
+	delete(o, "password_confirmation")
+
+	o["status"] = "active"
+	o["api_key"] = fmt.Sprintf("onappgotest-api-key-%d", o.int("id"))
+}
+
+func (s *Server) makeNewAPIKey(r *request) (int, interface{}) {
+	o := s.store.get(users, r.id())
+	if o == nil {
+		return notFound()
+	}
+
+	o["api_key"] = fmt.Sprintf("onappgotest-api-key-%d-%d", o.int("id"), time.Now().UnixNano())
+	return http.StatusOK, map[string]interface{}{"user": o}
+}
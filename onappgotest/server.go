@@ -0,0 +1,348 @@
+// Package onappgotest provides an in-memory fake of the OnApp Control Panel
+// for the tests of the programs using onappgo.
+//
+// The fake serves the endpoints of the virtual machines with their disks,
+// network interfaces, firewall rules and recipe joins, transactions,
+// networks, IP nets and ranges, users, buckets, backups and compute resources
+// from a stateful store. Changes made by transactions on the real Control
+// Panel are applied when the fake transactions complete: every time a
+// transaction is fetched by ID it moves one step from pending to running to
+// complete, so TransactionsService.Wait finishes after a few polls.
+//
+//	srv := onappgotest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	vm, _, err := client.VirtualMachines.Create(ctx, createRequest)
+//	...
+//	srv.FailNext("startup_virtual_machine")
+package onappgotest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+// Default credentials accepted by the Server.
+const (
+	DefaultEmail  = "admin@example.com"
+	DefaultAPIKey = "onappgotest-api-key"
+)
+
+// DefaultVersion is the version of OnApp reported by the Server.
+const DefaultVersion = "6.5.0-12"
+
+// Server is a fake OnApp Control Panel.
+type Server struct {
+	*httptest.Server
+
+	// Credentials required by the server, DefaultEmail and DefaultAPIKey by
+	// default. An empty Email disables the authentication.
+	Email  string
+	APIKey string
+
+	// Version of OnApp reported by the server, DefaultVersion by default
+	Version string
+
+	mu      sync.Mutex
+	store   *store
+	routes  []route
+	effects map[int]func()
+	doomed  map[int]bool
+	failing map[string]int
+}
+
+// NewServer starts a fake Control Panel. It must be closed by the caller.
+func NewServer() *Server {
+	s := &Server{
+		Email:   DefaultEmail,
+		APIKey:  DefaultAPIKey,
+		Version: DefaultVersion,
+		store:   newStore(),
+		effects: make(map[int]func()),
+		doomed:  make(map[int]bool),
+		failing: make(map[string]int),
+	}
+	s.registerRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a client of the server. The options are applied after the
+// ones setting the URL and the credentials of the server.
+func (s *Server) Client(opts ...onappgo.ClientOpt) *onappgo.Client {
+	opts = append([]onappgo.ClientOpt{onappgo.SetBasicAuth(s.Email, s.APIKey)}, opts...)
+
+	client, err := onappgo.New(nil, opts...)
+	if err != nil {
+		panic(fmt.Sprintf("onappgotest: %v", err))
+	}
+
+	client.BaseURL, _ = url.Parse(s.URL)
+	return client
+}
+
+// request is a request matched by a route.
+type request struct {
+	*http.Request
+
+	// IDs and names captured by the route
+	ids   []int
+	names []string
+
+	// JSON body, nil when empty
+	body map[string]interface{}
+}
+
+// id returns the last ID of the path.
+func (r *request) id() int {
+	return r.ids[len(r.ids)-1]
+}
+
+// object returns the object sent under the root key, or the body itself for
+// the endpoints the SDK sends without root.
+func (r *request) object(root string) object {
+	if o, ok := r.body[root].(map[string]interface{}); ok {
+		return o
+	}
+
+	if r.body == nil {
+		return object{}
+	}
+
+	return r.body
+}
+
+type handler func(r *request) (int, interface{})
+
+type route struct {
+	method  string
+	pattern []string
+	handle  handler
+}
+
+// match checks the path segments against the pattern, where ":id" matches
+// an ID and ":name" any segment.
+func (rt *route) match(method string, segs []string) (*request, bool) {
+	if method != rt.method || len(segs) != len(rt.pattern) {
+		return nil, false
+	}
+
+	r := &request{}
+	for i, p := range rt.pattern {
+		switch p {
+		case ":id":
+			id, err := strconv.Atoi(segs[i])
+			if err != nil {
+				return nil, false
+			}
+			r.ids = append(r.ids, id)
+		case ":name":
+			r.names = append(r.names, segs[i])
+		default:
+			if p != segs[i] {
+				return nil, false
+			}
+		}
+	}
+
+	return r, true
+}
+
+func (s *Server) handle(method, pattern string, h handler) {
+	s.routes = append(s.routes, route{
+		method:  method,
+		pattern: strings.Split(pattern, "/"),
+		handle:  h,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if id := req.Header.Get("X-Request-Id"); id != "" {
+		w.Header().Set("X-Request-Id", id)
+	}
+
+	if s.Email != "" {
+		email, key, ok := req.BasicAuth()
+		if !ok || email != s.Email || key != s.APIKey {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{
+				"error": "You need to sign in or sign up before continuing.",
+			})
+			return
+		}
+	}
+
+	path := strings.TrimSuffix(strings.Trim(req.URL.Path, "/"), ".json")
+	segs := strings.Split(path, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.routes {
+		r, ok := s.routes[i].match(req.Method, segs)
+		if !ok {
+			continue
+		}
+
+		r.Request = req
+		if err := json.NewDecoder(req.Body).Decode(&r.body); err != nil && !errors.Is(err, io.EOF) {
+			writeJSON(w, http.StatusBadRequest, errorsBody("Invalid JSON body: "+err.Error()))
+			return
+		}
+
+		status, v := s.routes[i].handle(r)
+		if p, ok := v.(*page); ok {
+			p.writeHeaders(w)
+			v = p.items
+		}
+		writeJSON(w, status, v)
+		return
+	}
+
+	writeJSON(w, http.StatusNotFound, errorsBody("Resource not found"))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	if v == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func errorsBody(messages ...string) map[string]interface{} {
+	return map[string]interface{}{"errors": messages}
+}
+
+func notFound() (int, interface{}) {
+	return http.StatusNotFound, errorsBody("Resource not found")
+}
+
+// validationFailed returns the field errors as sent by the Control Panel.
+func validationFailed(fields map[string][]string) (int, interface{}) {
+	return http.StatusUnprocessableEntity, map[string]interface{}{"errors": fields}
+}
+
+// page is a page of a list, sent with the pagination headers.
+type page struct {
+	items   []interface{}
+	page    int
+	perPage int
+	total   int
+}
+
+func (p *page) writeHeaders(w http.ResponseWriter) {
+	if p.perPage == 0 {
+		return
+	}
+
+	w.Header().Set("X-Page", strconv.Itoa(p.page))
+	w.Header().Set("X-Limit", strconv.Itoa(p.perPage))
+	w.Header().Set("X-Total", strconv.Itoa(p.total))
+}
+
+// list returns the page of the objects asked by the page and per_page
+// parameters, every object under the root key.
+func list(r *request, root string, objs []object) (int, interface{}) {
+	p := &page{items: []interface{}{}, total: len(objs)}
+
+	q := r.URL.Query()
+	p.page, _ = strconv.Atoi(q.Get("page"))
+	p.perPage, _ = strconv.Atoi(q.Get("per_page"))
+	if p.page < 1 {
+		p.page = 1
+	}
+
+	if p.perPage > 0 {
+		start := (p.page - 1) * p.perPage
+		if start > len(objs) {
+			start = len(objs)
+		}
+		end := start + p.perPage
+		if end > len(objs) {
+			end = len(objs)
+		}
+		objs = objs[start:end]
+	}
+
+	for _, o := range objs {
+		p.items = append(p.items, map[string]interface{}{root: o})
+	}
+
+	return http.StatusOK, p
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// decode converts the stored object to v, one of the onappgo types.
+func decode(o object, v interface{}) {
+	data, err := json.Marshal(o)
+	if err != nil {
+		panic(fmt.Sprintf("onappgotest: %v", err))
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(fmt.Sprintf("onappgotest: %v", err))
+	}
+}
+
+// VirtualMachine returns the stored virtual machine, nil if it doesn't exist.
+func (s *Server) VirtualMachine(id int) *onappgo.VirtualMachine {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.store.get(virtualMachines, id)
+	if o == nil {
+		return nil
+	}
+
+	vm := new(onappgo.VirtualMachine)
+	decode(o, vm)
+	return vm
+}
+
+// Disk returns the stored disk, nil if it doesn't exist.
+func (s *Server) Disk(id int) *onappgo.Disk {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.store.get(disks, id)
+	if o == nil {
+		return nil
+	}
+
+	disk := new(onappgo.Disk)
+	decode(o, disk)
+	return disk
+}
+
+// Transactions returns all the transactions, the oldest first.
+func (s *Server) Transactions() []onappgo.Transaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objs := s.store.all(transactions)
+	trxs := make([]onappgo.Transaction, len(objs))
+	for i, o := range objs {
+		decode(o, &trxs[i])
+	}
+
+	return trxs
+}
@@ -0,0 +1,349 @@
+package onappgotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+var waitOptions = &onappgo.TransactionWaitOptions{PollInterval: time.Millisecond}
+
+func TestServer_virtualMachineLifecycle(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	network, _, err := client.Networks.Create(ctx, &onappgo.NetworkCreateRequest{Label: "public"})
+	require.NoError(t, err)
+
+	_, _, err = client.IPNets.Create(ctx, network.ID, &onappgo.IPNetCreateRequest{
+		Label:             "public v4",
+		NetworkAddress:    "192.0.2.0",
+		NetworkMask:       24,
+		DefaultGateway:    "192.0.2.1",
+		AddDefaultIPRange: 1,
+	})
+	require.NoError(t, err)
+
+	vm, _, err := client.VirtualMachines.Create(ctx, &onappgo.VirtualMachineCreateRequest{
+		Label:                         "web",
+		Hostname:                      "web",
+		TemplateID:                    3,
+		Memory:                        1024,
+		Cpus:                          1,
+		PrimaryDiskSize:               10,
+		NetworkID:                     network.ID,
+		RequiredVirtualMachineStartup: true,
+	})
+	require.NoError(t, err)
+	require.False(t, vm.Built)
+	require.Len(t, vm.IPAddresses, 1)
+	require.Equal(t, "192.0.2.1", vm.IPAddresses[0].IPAddress.Address)
+
+	trxs, _, err := client.VirtualMachines.Transactions(ctx, vm.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, trxs, 2)
+
+	trx, _, err := client.Transactions.Wait(ctx, trxs[len(trxs)-1].ID, waitOptions)
+	require.NoError(t, err)
+	require.Equal(t, "configure_operating_system", trx.Action)
+
+	// the startup is queued once the build is done
+	trxs, _, err = client.VirtualMachines.Transactions(ctx, vm.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, trxs, 3)
+
+	trx, _, err = client.Transactions.Wait(ctx, trxs[0].ID, waitOptions)
+	require.NoError(t, err)
+	require.Equal(t, "startup_virtual_machine", trx.Action)
+
+	vm, _, err = client.VirtualMachines.Get(ctx, vm.ID)
+	require.NoError(t, err)
+	require.True(t, vm.Built)
+	require.True(t, vm.Booted)
+
+	trx, _, err = client.VirtualMachineActions.Stop(ctx, vm.ID)
+	require.NoError(t, err)
+	require.Equal(t, "stop_virtual_machine", trx.Action)
+	_, _, err = client.Transactions.Wait(ctx, trx.ID, waitOptions)
+	require.NoError(t, err)
+	require.False(t, srv.VirtualMachine(vm.ID).Booted)
+
+	srv.FailNext("reboot_virtual_machine")
+	trx, _, err = client.VirtualMachineActions.Reboot(ctx, vm.ID)
+	require.NoError(t, err)
+	_, _, err = client.Transactions.Wait(ctx, trx.ID, waitOptions)
+
+	var trxErr *onappgo.TransactionError
+	require.True(t, errors.As(err, &trxErr))
+	require.Equal(t, onappgo.TransactionFailed, trxErr.Transaction.Status)
+	require.False(t, srv.VirtualMachine(vm.ID).Booted)
+
+	trx, _, err = client.VirtualMachines.Delete(ctx, vm.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, "destroy_virtual_machine", trx.Action)
+	_, _, err = client.Transactions.Wait(ctx, trx.ID, waitOptions)
+	require.NoError(t, err)
+
+	_, _, err = client.VirtualMachines.Get(ctx, vm.ID)
+	require.True(t, onappgo.IsNotFound(err))
+	require.Nil(t, srv.Disk(1))
+}
+
+func TestServer_virtualMachineActions(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	vm, _, err := client.VirtualMachines.Create(ctx, &onappgo.VirtualMachineCreateRequest{
+		Label:           "web",
+		Hostname:        "web",
+		TemplateID:      3,
+		Memory:          1024,
+		Cpus:            1,
+		PrimaryDiskSize: 10,
+	})
+	require.NoError(t, err)
+	srv.Settle()
+
+	_, _, err = client.VirtualMachineActions.ChangeOwner(ctx, vm.ID, 4)
+	require.NoError(t, err)
+	require.Equal(t, 4, srv.VirtualMachine(vm.ID).UserID)
+
+	_, _, err = client.VirtualMachineActions.SetVIP(ctx, vm.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, "1", srv.VirtualMachine(vm.ID).Vip)
+
+	trx, _, err := client.VirtualMachineActions.BootFromISO(ctx, vm.ID, &onappgo.VirtualMachineISOBootRequest{IsoID: 3, CDboot: true})
+	require.NoError(t, err)
+	_, _, err = client.Transactions.Wait(ctx, trx.ID, waitOptions)
+	require.NoError(t, err)
+
+	got := srv.VirtualMachine(vm.ID)
+	require.True(t, got.Booted)
+	require.Equal(t, 3, got.IsoID)
+	require.True(t, got.CDboot)
+}
+
+func TestServer_failedChainIsCancelled(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.FailNext("build_disk")
+	vm, _, err := srv.Client().VirtualMachines.Create(ctx, &onappgo.VirtualMachineCreateRequest{
+		Label:           "db",
+		Hostname:        "db",
+		TemplateID:      3,
+		Memory:          2048,
+		Cpus:            2,
+		PrimaryDiskSize: 20,
+	})
+	require.NoError(t, err)
+
+	srv.Settle()
+
+	trxs := srv.Transactions()
+	require.Len(t, trxs, 2)
+	require.Equal(t, onappgo.TransactionFailed, trxs[0].Status)
+	require.Equal(t, onappgo.TransactionCancelled, trxs[1].Status)
+	require.False(t, srv.VirtualMachine(vm.ID).Built)
+}
+
+func TestServer_errors(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer()
+	defer srv.Close()
+
+	_, _, err := srv.Client().VirtualMachines.Create(ctx, &onappgo.VirtualMachineCreateRequest{Label: "web"})
+	require.True(t, onappgo.IsValidation(err))
+
+	errorResponse, _ := onappgo.AsErrorResponse(err)
+	require.Contains(t, errorResponse.FieldErrors(), "hostname")
+
+	_, _, err = srv.Client().Users.Get(ctx, 42)
+	require.True(t, onappgo.IsNotFound(err))
+
+	client := srv.Client(onappgo.SetBasicAuth(DefaultEmail, "wrong"))
+	_, _, err = client.Users.List(ctx, nil)
+	require.True(t, onappgo.IsUnauthorized(err))
+}
+
+func TestServer_editVirtualMachine(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	vm, _, err := client.VirtualMachines.Create(ctx, &onappgo.VirtualMachineCreateRequest{
+		Label:                         "web",
+		Hostname:                      "web",
+		TemplateID:                    3,
+		Memory:                        1024,
+		Cpus:                          1,
+		PrimaryDiskSize:               10,
+		RequiredVirtualMachineStartup: true,
+	})
+	require.NoError(t, err)
+	srv.Settle()
+
+	edit, _, err := client.VirtualMachines.Edit(ctx, vm.ID, &onappgo.VirtualMachineEditRequest{Label: "frontend"})
+	require.NoError(t, err)
+	require.Nil(t, edit.Transaction)
+	require.Equal(t, "frontend", srv.VirtualMachine(vm.ID).Label)
+
+	edit, _, err = client.VirtualMachines.Edit(ctx, vm.ID, &onappgo.VirtualMachineEditRequest{Cpus: 2, Memory: 2048})
+	require.NoError(t, err)
+	require.False(t, edit.RebootRequired)
+	require.True(t, edit.MayHotMigrate)
+	require.Equal(t, "resize_vm_without_reboot", edit.Transaction.Action)
+
+	_, _, err = client.Transactions.Wait(ctx, edit.Transaction.ID, waitOptions)
+	require.NoError(t, err)
+	require.Equal(t, 2048, srv.VirtualMachine(vm.ID).Memory)
+
+	edit, _, err = client.VirtualMachines.Edit(ctx, vm.ID, &onappgo.VirtualMachineEditRequest{Memory: 1024})
+	require.NoError(t, err)
+	require.True(t, edit.RebootRequired)
+	require.Equal(t, "resize_vm", edit.Transaction.Action)
+}
+
+func TestServer_migrateVirtualMachine(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	vm, _, err := client.VirtualMachines.Create(ctx, &onappgo.VirtualMachineCreateRequest{
+		Label:                         "web",
+		Hostname:                      "web",
+		TemplateID:                    3,
+		Memory:                        1024,
+		Cpus:                          1,
+		PrimaryDiskSize:               10,
+		RequiredVirtualMachineStartup: true,
+	})
+	require.NoError(t, err)
+	srv.Settle()
+
+	hv, _, err := client.Hypervisors.Create(ctx, &onappgo.HypervisorCreateRequest{Label: "kvm-2", Enabled: true})
+	require.NoError(t, err)
+
+	reasons, _, err := client.VirtualMachineActions.CanHotMigrate(ctx, vm.ID, &onappgo.VirtualMachineMigrateRequest{Destination: hv.ID})
+	require.NoError(t, err)
+	require.Empty(t, reasons)
+
+	migration, _, err := client.VirtualMachineActions.Migrate(ctx, vm.ID, &onappgo.VirtualMachineMigrateRequest{Destination: hv.ID})
+	require.NoError(t, err)
+	require.Equal(t, onappgo.MigrationHot, migration.Mode)
+	require.Len(t, migration.Transactions, 1)
+	require.Equal(t, "hot_migrate", migration.Transactions[0].Action)
+	srv.Settle()
+	require.Equal(t, hv.ID, srv.VirtualMachine(vm.ID).HypervisorID)
+	require.True(t, srv.VirtualMachine(vm.ID).Booted)
+
+	back, _, err := client.Hypervisors.Create(ctx, &onappgo.HypervisorCreateRequest{Label: "kvm-1", Enabled: true})
+	require.NoError(t, err)
+
+	toDataStore := &onappgo.VirtualMachineMigrateRequest{Destination: back.ID, Mode: onappgo.MigrationHot, DataStoreID: 7}
+	_, _, err = client.VirtualMachineActions.Migrate(ctx, vm.ID, toDataStore)
+	var migrationErr *onappgo.MigrationError
+	require.ErrorAs(t, err, &migrationErr)
+	require.Equal(t, []string{"its disks are moved to another data store"}, migrationErr.Reasons)
+
+	// the disks are moved once the virtual machine is unlocked
+	toDataStore.Mode = onappgo.MigrationAuto
+	toDataStore.Wait = waitOptions
+	migration, _, err = client.VirtualMachineActions.Migrate(ctx, vm.ID, toDataStore)
+	require.NoError(t, err)
+	require.Equal(t, onappgo.MigrationCold, migration.Mode)
+
+	var actions []string
+	for _, trx := range migration.Transactions {
+		actions = append(actions, trx.Action)
+	}
+	require.Equal(t, []string{"stop_virtual_machine", "cold_migrate", "startup_virtual_machine", "migrate_disk"}, actions)
+
+	srv.Settle()
+	require.Equal(t, back.ID, srv.VirtualMachine(vm.ID).HypervisorID)
+	disks, _, err := client.VirtualMachines.Disks(ctx, vm.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, 7, disks[0].DataStoreID)
+
+	// the disks stay when the migration of the virtual machine fails
+	srv.FailNext("cold_migrate")
+	migration, _, err = client.VirtualMachineActions.Migrate(ctx, vm.ID, &onappgo.VirtualMachineMigrateRequest{
+		Destination: hv.ID,
+		DataStoreID: 8,
+		Wait:        waitOptions,
+	})
+	var trxErr *onappgo.TransactionError
+	require.ErrorAs(t, err, &trxErr)
+	require.Equal(t, "cold_migrate", trxErr.Transaction.Action)
+	require.Len(t, migration.Transactions, 3)
+	require.Equal(t, back.ID, srv.VirtualMachine(vm.ID).HypervisorID)
+	disks, _, err = client.VirtualMachines.Disks(ctx, vm.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, 7, disks[0].DataStoreID)
+}
+
+func TestServer_createAndWait(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	network, _, err := client.Networks.Create(ctx, &onappgo.NetworkCreateRequest{Label: "public"})
+	require.NoError(t, err)
+	_, _, err = client.IPNets.Create(ctx, network.ID, &onappgo.IPNetCreateRequest{
+		Label:             "public v4",
+		NetworkAddress:    "192.0.2.0",
+		NetworkMask:       24,
+		DefaultGateway:    "192.0.2.1",
+		AddDefaultIPRange: 1,
+	})
+	require.NoError(t, err)
+
+	createRequest := &onappgo.VirtualMachineCreateRequest{
+		Label:                         "web",
+		Hostname:                      "web",
+		TemplateID:                    3,
+		Memory:                        1024,
+		Cpus:                          1,
+		PrimaryDiskSize:               10,
+		NetworkID:                     network.ID,
+		RecipeJoinsAttributes:         []string{"4"},
+		RequiredVirtualMachineStartup: true,
+	}
+	opts := &onappgo.VirtualMachineBuildOptions{Wait: waitOptions, Rollback: true}
+
+	build, _, err := client.VirtualMachines.CreateAndWait(ctx, createRequest, opts)
+	require.NoError(t, err)
+	require.True(t, build.VirtualMachine.Booted)
+	require.Len(t, build.IPAddresses, 1)
+	require.NotEmpty(t, build.InitialRootPassword)
+	require.Len(t, build.Transactions, 4)
+	require.Equal(t, "startup_virtual_machine", build.Transactions[3].Action)
+
+	srv.FailNext("run_recipe_on_vm")
+	build, _, err = client.VirtualMachines.CreateAndWait(ctx, createRequest, opts)
+
+	var buildErr *onappgo.BuildError
+	require.ErrorAs(t, err, &buildErr)
+	require.True(t, buildErr.RecipeFailed)
+	require.True(t, buildErr.RolledBack)
+	require.Equal(t, "run_recipe_on_vm", buildErr.Transaction.Action)
+	require.Nil(t, srv.VirtualMachine(build.VirtualMachine.ID))
+
+	// the address of the rolled back virtual machine is free again
+	createRequest.SelectedIPAddress = build.VirtualMachine.IPAddresses[0].IPAddress.Address
+	_, _, err = client.VirtualMachines.Create(ctx, createRequest)
+	require.NoError(t, err)
+}
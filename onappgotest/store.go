@@ -0,0 +1,140 @@
+package onappgotest
+
+import (
+	"sort"
+)
+
+// Collections of the store
+const (
+	virtualMachines = "virtual_machines"
+	disks           = "disks"
+	transactions    = "transactions"
+	networks        = "networks"
+	ipNets          = "ip_nets"
+	ipRanges        = "ip_ranges"
+	ipAddresses     = "ip_addresses"
+	users           = "users"
+	buckets         = "buckets"
+	backups         = "backups"
+	hypervisors     = "hypervisors"
+
+	networkInterfaces = "network_interfaces"
+	firewallRules     = "firewall_rules"
+	recipeJoins       = "recipe_joins"
+)
+
+// object is a stored resource, as sent in the JSON bodies.
+type object map[string]interface{}
+
+// int returns the numeric field, JSON numbers are decoded as float64.
+func (o object) int(key string) int {
+	switch v := o[key].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+
+	return 0
+}
+
+func (o object) string(key string) string {
+	s, _ := o[key].(string)
+	return s
+}
+
+func (o object) bool(key string) bool {
+	switch v := o[key].(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case int:
+		return v != 0
+	case string:
+		return v == "1" || v == "true"
+	}
+
+	return false
+}
+
+// merge copies the fields of src, except the ID.
+func (o object) merge(src object) {
+	for k, v := range src {
+		if k != "id" {
+			o[k] = v
+		}
+	}
+	o["updated_at"] = now()
+}
+
+// store keeps the objects by collection and ID.
+type store struct {
+	objects map[string]map[int]object
+	lastID  map[string]int
+}
+
+func newStore() *store {
+	return &store{
+		objects: make(map[string]map[int]object),
+		lastID:  make(map[string]int),
+	}
+}
+
+// insert stores o with a new ID, which is returned.
+func (s *store) insert(coll string, o object) object {
+	if s.objects[coll] == nil {
+		s.objects[coll] = make(map[int]object)
+	}
+
+	s.lastID[coll]++
+	o["id"] = s.lastID[coll]
+	if _, ok := o["created_at"]; !ok {
+		o["created_at"] = now()
+	}
+	o["updated_at"] = o["created_at"]
+
+	s.objects[coll][s.lastID[coll]] = o
+	return o
+}
+
+func (s *store) get(coll string, id int) object {
+	return s.objects[coll][id]
+}
+
+func (s *store) delete(coll string, id int) {
+	delete(s.objects[coll], id)
+}
+
+// all returns the objects of the collection sorted by ID.
+func (s *store) all(coll string) []object {
+	return s.where(coll, func(object) bool { return true })
+}
+
+// where returns the objects of the collection matching the filter, sorted by
+// ID.
+func (s *store) where(coll string, filter func(object) bool) []object {
+	objs := make([]object, 0, len(s.objects[coll]))
+	for _, o := range s.objects[coll] {
+		if filter(o) {
+			objs = append(objs, o)
+		}
+	}
+
+	sort.Slice(objs, func(i, j int) bool { return objs[i].int("id") < objs[j].int("id") })
+	return objs
+}
+
+// deleteWhere removes the objects of the collection matching the filter.
+func (s *store) deleteWhere(coll string, filter func(object) bool) {
+	for id, o := range s.objects[coll] {
+		if filter(o) {
+			delete(s.objects[coll], id)
+		}
+	}
+}
+
+// fieldIs returns a filter of the objects having the numeric field set to v.
+func fieldIs(key string, v int) func(object) bool {
+	return func(o object) bool { return o.int(key) == v }
+}
@@ -0,0 +1,224 @@
+package onappgotest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+// step is a transaction to queue.
+type step struct {
+	action     string
+	objectType string
+	objectID   int
+	parentType string
+	parentID   int
+
+	// effect is applied to the store when the transaction completes
+	effect func()
+}
+
+// vmStep returns a step of the virtual machine.
+func vmStep(action string, vmID int, effect func()) step {
+	return step{
+		action:     action,
+		objectType: "VirtualMachine",
+		objectID:   vmID,
+		parentType: "VirtualMachine",
+		parentID:   vmID,
+		effect:     effect,
+	}
+}
+
+// queue creates pending transactions. When there are several steps they are
+// chained, every transaction depending on the previous one.
+func (s *Server) queue(r *request, steps ...step) []object {
+	actor, _, _ := r.BasicAuth()
+
+	var chain []object
+	for i, st := range steps {
+		o := s.store.insert(transactions, object{
+			"action":                 st.action,
+			"actor":                  actor,
+			"allowed_cancel":         true,
+			"associated_object_id":   st.objectID,
+			"associated_object_type": st.objectType,
+			"parent_id":              st.parentID,
+			"parent_type":            st.parentType,
+			"status":                 onappgo.TransactionPending,
+			"priority":               5,
+		})
+
+		id := o.int("id")
+		if len(steps) > 1 {
+			o["chain_id"] = id
+		}
+		if i > 0 {
+			o["chain_id"] = chain[0].int("id")
+			o["dependent_transaction_id"] = chain[i-1].int("id")
+		}
+
+		s.effects[id] = st.effect
+		if s.failing[st.action] > 0 {
+			s.failing[st.action]--
+			s.doomed[id] = true
+		}
+
+		chain = append(chain, o)
+	}
+
+	return chain
+}
+
+// advance moves the transaction one step further. Pending transactions wait
+// for the one they depend on, and are cancelled if it didn't complete.
+func (s *Server) advance(o object) bool {
+	switch o.string("status") {
+	case onappgo.TransactionPending:
+		if dep := s.store.get(transactions, o.int("dependent_transaction_id")); dep != nil {
+			switch dep.string("status") {
+			case onappgo.TransactionFailed, onappgo.TransactionCancelled:
+				s.finish(o, onappgo.TransactionCancelled)
+				return true
+			case onappgo.TransactionComplete:
+			default:
+				return false
+			}
+		}
+
+		o["status"] = onappgo.TransactionRunning
+		o["started_at"] = now()
+		o["updated_at"] = now()
+		return true
+
+	case onappgo.TransactionRunning:
+		id := o.int("id")
+		if s.doomed[id] {
+			s.finish(o, onappgo.TransactionFailed)
+			return true
+		}
+
+		if effect := s.effects[id]; effect != nil {
+			effect()
+		}
+		s.finish(o, onappgo.TransactionComplete)
+		return true
+	}
+
+	return false
+}
+
+func (s *Server) finish(o object, status string) {
+	o["status"] = status
+	o["updated_at"] = now()
+	delete(s.effects, o.int("id"))
+	delete(s.doomed, o.int("id"))
+}
+
+// locked reports whether the virtual machine has unfinished transactions.
+func (s *Server) locked(vmID int) bool {
+	return len(s.store.where(transactions, func(o object) bool {
+		if o.string("associated_object_type") != "VirtualMachine" || o.int("associated_object_id") != vmID {
+			return false
+		}
+
+		status := o.string("status")
+		return status == onappgo.TransactionPending || status == onappgo.TransactionRunning
+	})) > 0
+}
+
+// FailNext makes the next transaction of the action fail, for example
+// "startup_virtual_machine". The transactions depending on it are cancelled.
+func (s *Server) FailNext(action string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failing[action]++
+}
+
+// Settle finishes every queued transaction at once.
+func (s *Server) Settle() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for changed := true; changed; {
+		changed = false
+		for _, o := range s.store.all(transactions) {
+			if s.advance(o) {
+				changed = true
+			}
+		}
+	}
+}
+
+func (s *Server) transactionRoutes() {
+	s.handle(http.MethodGet, "transactions", s.listTransactions)
+	s.handle(http.MethodGet, "transactions/:id", s.getTransaction)
+	s.handle(http.MethodGet, "virtual_machines/:id/transactions", s.listVirtualMachineTransactions)
+}
+
+// listTransactions filters the transactions like the Control Panel, the
+// newest first.
+func (s *Server) listTransactions(r *request) (int, interface{}) {
+	q := r.URL.Query()
+
+	var since time.Time
+	if v := q.Get("since"); v != "" {
+		since, _ = time.Parse(time.RFC3339, v)
+	}
+
+	objs := s.store.where(transactions, func(o object) bool {
+		for _, key := range []string{"associated_object_id", "parent_id"} {
+			if v := q.Get(key); v != "" && strconv.Itoa(o.int(key)) != v {
+				return false
+			}
+		}
+
+		for _, key := range []string{"associated_object_type", "parent_type", "status", "action"} {
+			if v := q.Get(key); v != "" && o.string(key) != v {
+				return false
+			}
+		}
+
+		if !since.IsZero() {
+			createdAt, err := time.Parse(time.RFC3339, o.string("created_at"))
+			if err == nil && createdAt.Before(since) {
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return list(r, "transaction", newestFirst(objs))
+}
+
+func (s *Server) getTransaction(r *request) (int, interface{}) {
+	o := s.store.get(transactions, r.id())
+	if o == nil {
+		return notFound()
+	}
+
+	s.advance(o)
+	return http.StatusOK, map[string]interface{}{"transaction": o}
+}
+
+func (s *Server) listVirtualMachineTransactions(r *request) (int, interface{}) {
+	if s.store.get(virtualMachines, r.id()) == nil {
+		return notFound()
+	}
+
+	objs := s.store.where(transactions, func(o object) bool {
+		return o.string("associated_object_type") == "VirtualMachine" && o.int("associated_object_id") == r.id()
+	})
+
+	return list(r, "transaction", newestFirst(objs))
+}
+
+func newestFirst(objs []object) []object {
+	sort.Slice(objs, func(i, j int) bool { return objs[i].int("id") > objs[j].int("id") })
+	return objs
+}
@@ -0,0 +1,746 @@
+package onappgotest
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+)
+
+// vmFields are the fields of the create request kept by the virtual machine.
+var vmFields = []string{
+	"admin_note",
+	"cpu_shares",
+	"cpu_sockets",
+	"cpus",
+	"domain",
+	"hostname",
+	"hypervisor_id",
+	"initial_root_password",
+	"instance_package_id",
+	"label",
+	"memory",
+	"template_id",
+	"time_zone",
+}
+
+// vmAction is an action of the virtual machines, served at
+// virtual_machines/:id/<name>.
+type vmAction struct {
+	// action of the transaction, empty if the action is done at once
+	action string
+
+	// apply changes the virtual machine once done, params is the object sent
+	// under the "virtual_machine" root, or without root for unrootedActions
+	apply func(vm object, params object)
+}
+
+// unrootedActions are the actions whose params are sent without the
+// "virtual_machine" root.
+var unrootedActions = map[string]bool{
+	"change_owner": true,
+}
+
+var vmActions = map[string]vmAction{
+	"startup": {"startup_virtual_machine", func(vm, params object) {
+		vm["booted"] = true
+		if iso := params.int("iso_id"); iso != 0 {
+			vm["iso_id"] = iso
+			vm["cdboot"] = params.bool("cdboot")
+		}
+	}},
+	"shutdown": {"stop_virtual_machine", func(vm, _ object) {
+		vm["booted"] = false
+	}},
+	"stop": {"stop_virtual_machine", func(vm, _ object) {
+		vm["booted"] = false
+	}},
+	"reboot": {"reboot_virtual_machine", func(vm, _ object) {
+		vm["booted"] = true
+	}},
+	"suspend": {"stop_virtual_machine", func(vm, _ object) {
+		vm["suspended"] = !vm.bool("suspended")
+		vm["booted"] = false
+	}},
+	"unlock": {"", func(vm, _ object) {
+		vm["locked"] = false
+	}},
+	"reset_password": {"reset_root_password", func(vm, params object) {
+		vm["initial_root_password"] = params.string("initial_root_password")
+	}},
+	"fqdn": {"update_fqdn", func(vm, params object) {
+		vm["hostname"] = params.string("hostname")
+		vm["domain"] = params.string("domain")
+	}},
+	"rebuild_network": {"rebuild_network", nil},
+	"build": {"build_virtual_machine", func(vm, params object) {
+		if template := params.int("template_id"); template != 0 {
+			vm["template_id"] = template
+		}
+		vm["built"] = true
+		vm["booted"] = params.bool("required_startup")
+	}},
+	"strict_vm": {"", func(vm, params object) {
+		vm["strict_virtual_machine_id"] = params.int("strict_virtual_machine_id")
+	}},
+	"change_owner": {"", func(vm, params object) {
+		vm["user_id"] = params.int("user_id")
+	}},
+	"set_vip": {"", func(vm, params object) {
+		// the SDK decodes the VIP status as a string
+		vm["vip"] = "0"
+		if params.bool("vip") {
+			vm["vip"] = "1"
+		}
+	}},
+}
+
+func (s *Server) virtualMachineRoutes() {
+	s.handle(http.MethodGet, "virtual_machines", func(r *request) (int, interface{}) {
+		return list(r, "virtual_machine", s.store.all(virtualMachines))
+	})
+	s.handle(http.MethodPost, "virtual_machines", s.createVirtualMachine)
+	s.handle(http.MethodGet, "virtual_machines/:id", s.getVirtualMachine)
+	s.handle(http.MethodPut, "virtual_machines/:id", s.editVirtualMachine)
+	s.handle(http.MethodDelete, "virtual_machines/:id", s.deleteVirtualMachine)
+
+	s.handle(http.MethodGet, "virtual_machines/:id/ip_addresses", s.listIPAddressJoins)
+	s.handle(http.MethodPost, "virtual_machines/:id/ip_addresses", s.assignVirtualMachineIPAddress)
+	s.handle(http.MethodDelete, "virtual_machines/:id/ip_addresses/:id", s.unassignVirtualMachineIPAddress)
+
+	s.crud("virtual_machines/:id/network_interfaces", &resource{
+		coll:     networkInterfaces,
+		root:     "network_interface",
+		parents:  []string{"virtual_machine"},
+		required: []string{"label"},
+	})
+	s.crud("virtual_machines/:id/firewall_rules", &resource{
+		coll:     firewallRules,
+		root:     "firewall_rule",
+		parents:  []string{"virtual_machine"},
+		required: []string{"command", "network_interface_id"},
+	})
+
+	// recipe joins are listed by event type
+	s.handle(http.MethodGet, "virtual_machines/:id/recipe_joins", s.listRecipeJoins)
+	s.crud("virtual_machines/:id/recipe_joins", &resource{
+		coll:     recipeJoins,
+		root:     "recipe_join",
+		parents:  []string{"virtual_machine"},
+		required: []string{"recipe_id", "event_type"},
+	})
+
+	s.handle(http.MethodPost, "virtual_machines/:id/migration", s.migrateVirtualMachine)
+	s.handle(http.MethodPost, "virtual_machines/:id/:name", s.virtualMachineAction)
+	s.handle(http.MethodPatch, "virtual_machines/:id/:name", s.virtualMachineAction)
+}
+
+func (s *Server) getVirtualMachine(r *request) (int, interface{}) {
+	vm := s.store.get(virtualMachines, r.id())
+	if vm == nil {
+		return notFound()
+	}
+
+	return http.StatusOK, map[string]interface{}{"virtual_machine": vm}
+}
+
+// createVirtualMachine stores the virtual machine with its disks and IP
+// address, and queues the chain building it. The chain of the recipes and
+// the startup is queued once the virtual machine is built.
+func (s *Server) createVirtualMachine(r *request) (int, interface{}) {
+	params := r.object("virtual_machine")
+	if errs := required(params, "label", "hostname", "template_id", "memory", "cpus", "primary_disk_size"); errs != nil {
+		return validationFailed(errs)
+	}
+
+	vm := object{}
+	for _, field := range vmFields {
+		if v, ok := params[field]; ok {
+			vm[field] = v
+		}
+	}
+
+	s.store.insert(virtualMachines, vm)
+	id := vm.int("id")
+
+	vm["identifier"] = fmt.Sprintf("onappgotest%06d", id)
+	vm["booted"] = false
+	vm["built"] = false
+	vm["locked"] = false
+	vm["suspended"] = false
+	vm["allowed_hot_migrate"] = true
+	vm["hot_add_cpu"] = "1"
+	vm["hot_add_memory"] = "1"
+	vm["state"] = "building"
+	vm["total_disk_size"] = params.int("primary_disk_size") + params.int("swap_disk_size")
+	if vm.string("initial_root_password") == "" {
+		vm["initial_root_password"] = fmt.Sprintf("onappgotest-%06d", id)
+	}
+
+	nic := s.store.insert(networkInterfaces, object{
+		"virtual_machine_id": id,
+		"label":              "eth0",
+		"primary":            true,
+		"connected":          true,
+	})
+	params["network_interface_id"] = nic.int("id")
+
+	if errs := s.assignIPAddress(vm, params); errs != nil {
+		s.store.delete(virtualMachines, id)
+		s.store.delete(networkInterfaces, nic.int("id"))
+		return validationFailed(errs)
+	}
+
+	var steps []step
+	sizes := []int{params.int("primary_disk_size"), params.int("swap_disk_size")}
+	for i, size := range sizes {
+		if size == 0 {
+			continue
+		}
+
+		disk := s.store.insert(disks, object{
+			"virtual_machine_id": id,
+			"disk_size":          size,
+			"primary":            i == 0,
+			"is_swap":            i == 1,
+			"label":              fmt.Sprintf("Disk %d", i+1),
+			"built":              false,
+		})
+		steps = append(steps, diskStep("build_disk", disk, func() {
+			disk["built"] = true
+		}))
+	}
+
+	// the provisioning recipes, sent as their IDs, and the startup are only
+	// queued once the operating system is configured, like OnApp does
+	var next []step
+	recipes, _ := params["recipe_joins_attributes"].([]interface{})
+	for range recipes {
+		next = append(next, vmStep("run_recipe_on_vm", id, nil))
+	}
+
+	if params.bool("required_virtual_machine_startup") {
+		next = append(next, vmStep("startup_virtual_machine", id, func() {
+			vm["booted"] = true
+		}))
+	}
+
+	steps = append(steps, vmStep("configure_operating_system", id, func() {
+		vm["built"] = true
+		vm["state"] = "built"
+		if len(next) > 0 {
+			s.queue(r, next...)
+		}
+	}))
+
+	s.queue(r, steps...)
+	return http.StatusCreated, map[string]interface{}{"virtual_machine": vm}
+}
+
+// assignIPAddress takes the selected or first free address of the ranges of
+// the network, IP net or range of the params, and joins it to the network
+// interface of the params. Nothing is assigned when there are no IP ranges.
+func (s *Server) assignIPAddress(vm object, params object) map[string][]string {
+	ranges := s.store.where(ipRanges, func(o object) bool {
+		for _, field := range []string{"network_id", "ip_net_id"} {
+			if id := params.int(field); id != 0 && o.int(field) != id {
+				return false
+			}
+		}
+
+		id := params.int("ip_range_id")
+		return id == 0 || o.int("id") == id
+	})
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	used := make(map[string]bool)
+	for _, ip := range s.store.all(ipAddresses) {
+		used[ip.string("address")] = true
+	}
+
+	selected := params.string("selected_ip_address")
+	for _, rng := range ranges {
+		start, err := netip.ParseAddr(rng.string("start_address"))
+		if err != nil {
+			continue
+		}
+		end, err := netip.ParseAddr(rng.string("end_address"))
+		if err != nil {
+			continue
+		}
+
+		for addr := start; addr.IsValid() && addr.Compare(end) <= 0; addr = addr.Next() {
+			if used[addr.String()] || (selected != "" && addr.String() != selected) {
+				continue
+			}
+
+			ipNet := s.store.get(ipNets, rng.int("ip_net_id"))
+			ip := s.store.insert(ipAddresses, object{
+				"address":              addr.String(),
+				"gateway":              rng.string("default_gateway"),
+				"ip_net_id":            rng.int("ip_net_id"),
+				"ip_range_id":          rng.int("id"),
+				"ipv4":                 addr.Is4(),
+				"network_address":      ipNet.string("network_address"),
+				"network_id":           rng.int("network_id"),
+				"prefix":               ipNet.int("network_mask"),
+				"virtual_machine_id":   vm.int("id"),
+				"network_interface_id": params.int("network_interface_id"),
+			})
+			ips, _ := vm["ip_addresses"].([]interface{})
+			vm["ip_addresses"] = append(ips, map[string]interface{}{"ip_address": ip})
+			return nil
+		}
+	}
+
+	if selected != "" {
+		return map[string][]string{"selected_ip_address": {"is not available"}}
+	}
+
+	return map[string][]string{"network_id": {"has no free IP address"}}
+}
+
+// assignVirtualMachineIPAddress assigns an IP address to a network interface
+// of the virtual machine at once.
+func (s *Server) assignVirtualMachineIPAddress(r *request) (int, interface{}) {
+	vm := s.store.get(virtualMachines, r.id())
+	if vm == nil {
+		return notFound()
+	}
+
+	params := r.object("ip_address")
+	nic := s.store.get(networkInterfaces, params.int("network_interface_id"))
+	if nic == nil || nic.int("virtual_machine_id") != r.id() {
+		return validationFailed(map[string][]string{"network_interface_id": {"is invalid"}})
+	}
+
+	params["selected_ip_address"] = params.string("address")
+	if errs := s.assignIPAddress(vm, params); errs != nil {
+		if _, ok := errs["selected_ip_address"]; ok {
+			errs = map[string][]string{"address": errs["selected_ip_address"]}
+		}
+		return validationFailed(errs)
+	}
+
+	return http.StatusCreated, nil
+}
+
+// unassignVirtualMachineIPAddress releases an IP address of the virtual
+// machine at once, the IDs of the joins are the ones of the addresses.
+func (s *Server) unassignVirtualMachineIPAddress(r *request) (int, interface{}) {
+	vm := s.store.get(virtualMachines, r.ids[0])
+	ip := s.store.get(ipAddresses, r.id())
+	if vm == nil || ip == nil || ip.int("virtual_machine_id") != vm.int("id") {
+		return notFound()
+	}
+
+	s.store.delete(ipAddresses, ip.int("id"))
+
+	ips, _ := vm["ip_addresses"].([]interface{})
+	kept := []interface{}{}
+	for _, e := range ips {
+		if e.(map[string]interface{})["ip_address"].(object).int("id") != ip.int("id") {
+			kept = append(kept, e)
+		}
+	}
+	vm["ip_addresses"] = kept
+
+	return http.StatusNoContent, nil
+}
+
+// deleteVirtualMachine queues the destruction of the virtual machine, which
+// releases its disks, backups and IP addresses.
+func (s *Server) deleteVirtualMachine(r *request) (int, interface{}) {
+	id := r.id()
+	if s.store.get(virtualMachines, id) == nil {
+		return notFound()
+	}
+
+	s.queue(r, vmStep("destroy_virtual_machine", id, func() {
+		s.store.delete(virtualMachines, id)
+		s.store.deleteWhere(disks, fieldIs("virtual_machine_id", id))
+		s.store.deleteWhere(backups, fieldIs("target_id", id))
+		s.store.deleteWhere(ipAddresses, fieldIs("virtual_machine_id", id))
+		s.store.deleteWhere(networkInterfaces, fieldIs("virtual_machine_id", id))
+		s.store.deleteWhere(firewallRules, fieldIs("virtual_machine_id", id))
+		s.store.deleteWhere(recipeJoins, fieldIs("virtual_machine_id", id))
+	}))
+
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) virtualMachineAction(r *request) (int, interface{}) {
+	vm := s.store.get(virtualMachines, r.id())
+	if vm == nil {
+		return notFound()
+	}
+
+	a, ok := vmActions[r.names[0]]
+	if !ok {
+		return notFound()
+	}
+
+	// unlike r.object, the params are only read where the Control Panel
+	// expects them
+	params, _ := r.body["virtual_machine"].(map[string]interface{})
+	if unrootedActions[r.names[0]] {
+		params = r.body
+	}
+
+	effect := func() {
+		if a.apply != nil {
+			a.apply(vm, params)
+		}
+	}
+
+	if a.action == "" {
+		effect()
+	} else {
+		s.queue(r, vmStep(a.action, vm.int("id"), effect))
+	}
+
+	return http.StatusCreated, map[string]interface{}{"virtual_machine": vm}
+}
+
+// migrateVirtualMachine queues the move of the virtual machine to the
+// destination compute resource. The cold migration of a running virtual
+// machine stops it and starts it up again.
+func (s *Server) migrateVirtualMachine(r *request) (int, interface{}) {
+	vm := s.store.get(virtualMachines, r.id())
+	if vm == nil {
+		return notFound()
+	}
+
+	params := r.object("virtual_machine")
+	destination := params.int("destination")
+	if s.store.get(hypervisors, destination) == nil {
+		return validationFailed(map[string][]string{"destination": {"is invalid"}})
+	}
+
+	id := vm.int("id")
+	move := func() {
+		vm["hypervisor_id"] = destination
+	}
+
+	booted := vm.bool("booted")
+	switch {
+	case params.bool("hot_migrate"):
+		if !booted || !vm.bool("allowed_hot_migrate") {
+			return validationFailed(map[string][]string{"base": {"Virtual machine can't be hot migrated"}})
+		}
+		s.queue(r, vmStep("hot_migrate", id, move))
+	case booted:
+		s.queue(r,
+			vmStep("stop_virtual_machine", id, func() {
+				vm["booted"] = false
+			}),
+			vmStep("cold_migrate", id, move),
+			vmStep("startup_virtual_machine", id, func() {
+				vm["booted"] = true
+			}),
+		)
+	default:
+		s.queue(r, vmStep("cold_migrate", id, move))
+	}
+
+	return http.StatusNoContent, nil
+}
+
+// editVirtualMachine changes the label and the admin note at once, and
+// queues the resize of the resources.
+func (s *Server) editVirtualMachine(r *request) (int, interface{}) {
+	vm := s.store.get(virtualMachines, r.id())
+	if vm == nil {
+		return notFound()
+	}
+
+	params := r.object("virtual_machine")
+	for _, field := range []string{"label", "admin_note"} {
+		if v, ok := params[field]; ok {
+			vm[field] = v
+		}
+	}
+
+	resize := object{}
+	for _, field := range []string{"cpus", "cpu_shares", "cpu_sockets", "cores_per_socket", "memory"} {
+		if v, ok := params[field]; ok && fmt.Sprint(v) != fmt.Sprint(vm[field]) {
+			resize[field] = v
+		}
+	}
+
+	if len(resize) > 0 {
+		action := "resize_vm"
+		if vm.bool("booted") && !resizeRequiresReboot(vm, resize) {
+			action = "resize_vm_without_reboot"
+		}
+
+		s.queue(r, vmStep(action, vm.int("id"), func() {
+			vm.merge(resize)
+		}))
+	}
+
+	return http.StatusNoContent, nil
+}
+
+// resizeRequiresReboot reports whether the running virtual machine is
+// rebooted by the resize, like VirtualMachine.ResizeRequiresReboot.
+func resizeRequiresReboot(vm, resize object) bool {
+	if _, ok := resize["cpu_sockets"]; ok {
+		return true
+	}
+	if _, ok := resize["cores_per_socket"]; ok {
+		return true
+	}
+
+	for field, hotAdd := range map[string]string{"cpus": "hot_add_cpu", "memory": "hot_add_memory"} {
+		if _, ok := resize[field]; ok && (resize.int(field) < vm.int(field) || !vm.bool(hotAdd)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) listIPAddressJoins(r *request) (int, interface{}) {
+	if s.store.get(virtualMachines, r.id()) == nil {
+		return notFound()
+	}
+
+	var joins []object
+	for _, ip := range s.store.where(ipAddresses, fieldIs("virtual_machine_id", r.id())) {
+		joins = append(joins, object{
+			"id":                   ip.int("id"),
+			"ip_address_id":        ip.int("id"),
+			"ip_address":           ip,
+			"network_interface_id": ip.int("network_interface_id"),
+			"created_at":           ip.string("created_at"),
+			"updated_at":           ip.string("updated_at"),
+		})
+	}
+
+	return list(r, "ip_address_join", joins)
+}
+
+// listRecipeJoins lists the recipe joins of the virtual machine grouped by
+// event type.
+func (s *Server) listRecipeJoins(r *request) (int, interface{}) {
+	if s.store.get(virtualMachines, r.id()) == nil {
+		return notFound()
+	}
+
+	groups := map[string][]interface{}{}
+	for _, join := range s.store.where(recipeJoins, fieldIs("virtual_machine_id", r.id())) {
+		event := join.string("event_type")
+		groups[event] = append(groups[event], map[string]interface{}{"recipe_join": join})
+	}
+
+	return http.StatusOK, groups
+}
+
+// diskStep returns a step of the disk, the transactions of the disks are
+// associated with their virtual machine.
+func diskStep(action string, disk object, effect func()) step {
+	return step{
+		action:     action,
+		objectType: "VirtualMachine",
+		objectID:   disk.int("virtual_machine_id"),
+		parentType: "Disk",
+		parentID:   disk.int("id"),
+		effect:     effect,
+	}
+}
+
+func (s *Server) diskRoutes() {
+	s.handle(http.MethodGet, "settings/disks", func(r *request) (int, interface{}) {
+		return list(r, "disk", s.store.all(disks))
+	})
+
+	s.handle(http.MethodGet, "settings/disks/:id", func(r *request) (int, interface{}) {
+		disk := s.store.get(disks, r.id())
+		if disk == nil {
+			return notFound()
+		}
+
+		return http.StatusOK, map[string]interface{}{"disk": disk}
+	})
+
+	s.handle(http.MethodGet, "virtual_machines/:id/disks", func(r *request) (int, interface{}) {
+		if s.store.get(virtualMachines, r.id()) == nil {
+			return notFound()
+		}
+
+		return list(r, "disk", s.store.where(disks, fieldIs("virtual_machine_id", r.id())))
+	})
+
+	s.handle(http.MethodPost, "virtual_machines/:id/disks", s.createDisk)
+	s.handle(http.MethodPost, "virtual_machines/:id/disks/:id/migrate", s.migrateDisk)
+	s.handle(http.MethodPut, "settings/disks/:id", s.editDisk)
+	s.handle(http.MethodDelete, "settings/disks/:id", s.deleteDisk)
+}
+
+func (s *Server) createDisk(r *request) (int, interface{}) {
+	if s.store.get(virtualMachines, r.id()) == nil {
+		return notFound()
+	}
+
+	disk := r.object("disk")
+	if errs := required(disk, "disk_size"); errs != nil {
+		return validationFailed(errs)
+	}
+
+	if s.locked(r.id()) {
+		return validationFailed(map[string][]string{"base": {"Virtual machine is locked by a running transaction"}})
+	}
+
+	disk["virtual_machine_id"] = r.id()
+	disk["built"] = false
+	s.store.insert(disks, disk)
+
+	s.queue(r, diskStep("build_disk", disk, func() {
+		disk["built"] = true
+	}))
+
+	return http.StatusCreated, map[string]interface{}{"disk": disk}
+}
+
+// migrateDisk queues the move of the disk to another data store.
+func (s *Server) migrateDisk(r *request) (int, interface{}) {
+	disk := s.store.get(disks, r.id())
+	if disk == nil || disk.int("virtual_machine_id") != r.ids[0] {
+		return notFound()
+	}
+
+	dataStore := r.object("disk").int("data_store_id")
+	if dataStore == 0 {
+		return validationFailed(map[string][]string{"data_store_id": {"can't be blank"}})
+	}
+
+	if s.locked(r.ids[0]) {
+		return validationFailed(map[string][]string{"base": {"Virtual machine is locked by a running transaction"}})
+	}
+
+	s.queue(r, diskStep("migrate_disk", disk, func() {
+		disk["data_store_id"] = dataStore
+	}))
+
+	return http.StatusNoContent, nil
+}
+
+// editDisk resizes the disk with a transaction, other changes are done at
+// once.
+func (s *Server) editDisk(r *request) (int, interface{}) {
+	disk := s.store.get(disks, r.id())
+	if disk == nil {
+		return notFound()
+	}
+
+	params := r.object("disk")
+	size, resize := params["disk_size"]
+	delete(params, "disk_size")
+	disk.merge(params)
+
+	if resize {
+		s.queue(r, diskStep("resize_disk", disk, func() {
+			disk["disk_size"] = size
+		}))
+	}
+
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) deleteDisk(r *request) (int, interface{}) {
+	disk := s.store.get(disks, r.id())
+	if disk == nil {
+		return notFound()
+	}
+
+	s.queue(r, diskStep("destroy_disk", disk, func() {
+		s.store.delete(disks, disk.int("id"))
+		s.store.deleteWhere(backups, fieldIs("disk_id", disk.int("id")))
+	}))
+
+	return http.StatusNoContent, nil
+}
+
+// backupStep returns a step of the backup, associated with its virtual
+// machine.
+func backupStep(action string, backup object, effect func()) step {
+	return step{
+		action:     action,
+		objectType: "VirtualMachine",
+		objectID:   backup.int("target_id"),
+		parentType: "Backup",
+		parentID:   backup.int("id"),
+		effect:     effect,
+	}
+}
+
+func (s *Server) backupRoutes() {
+	s.handle(http.MethodGet, "virtual_machines/:id/backups", func(r *request) (int, interface{}) {
+		if s.store.get(virtualMachines, r.id()) == nil {
+			return notFound()
+		}
+
+		return list(r, "backup", s.store.where(backups, fieldIs("target_id", r.id())))
+	})
+
+	s.handle(http.MethodGet, "virtual_machines/:id/disks/:id/backups", func(r *request) (int, interface{}) {
+		disk := s.store.get(disks, r.id())
+		if disk == nil || disk.int("virtual_machine_id") != r.ids[0] {
+			return notFound()
+		}
+
+		return list(r, "backup", s.store.where(backups, fieldIs("disk_id", r.id())))
+	})
+
+	s.handle(http.MethodPost, "settings/disks/:id/backups", s.createBackup)
+
+	s.handle(http.MethodPut, "backups/:id/note", func(r *request) (int, interface{}) {
+		backup := s.store.get(backups, r.id())
+		if backup == nil {
+			return notFound()
+		}
+
+		backup.merge(object{"note": r.object("backup").string("note")})
+		return http.StatusNoContent, nil
+	})
+
+	s.handle(http.MethodDelete, "backups/:id", func(r *request) (int, interface{}) {
+		backup := s.store.get(backups, r.id())
+		if backup == nil {
+			return notFound()
+		}
+
+		s.queue(r, backupStep("destroy_backup", backup, func() {
+			s.store.delete(backups, backup.int("id"))
+		}))
+
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) createBackup(r *request) (int, interface{}) {
+	disk := s.store.get(disks, r.id())
+	if disk == nil {
+		return notFound()
+	}
+
+	backup := s.store.insert(backups, object{
+		"disk_id":     disk.int("id"),
+		"target_id":   disk.int("virtual_machine_id"),
+		"target_type": "VirtualMachine",
+		"backup_type": "normal",
+		"initiated":   "manual",
+		"note":        r.object("backup").string("note"),
+		"built":       false,
+	})
+
+	s.queue(r, backupStep("take_backup", backup, func() {
+		backup["built"] = true
+		backup["built_at"] = now()
+		backup["backup_size"] = disk.int("disk_size") * 1024 * 1024
+	}))
+
+	return http.StatusCreated, map[string]interface{}{"backup": backup}
+}
@@ -0,0 +1,103 @@
+package onappgo
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// apiOperation describes an API request in terms of the services of the
+// Client, for logs and traces.
+type apiOperation struct {
+	// Service name, for example "VirtualMachines"
+	Service string
+
+	// Method name, for example "Get" or "Startup" for an action
+	Method string
+
+	// Path with the IDs replaced by ":id", for example "virtual_machines/:id/startup"
+	Route string
+
+	// IDs found in the path
+	IDs []int
+}
+
+// Name returns the full name of the operation, for example "VirtualMachines.Get".
+func (op apiOperation) Name() string {
+	return op.Service + "." + op.Method
+}
+
+// newAPIOperation derives the operation from the request path: the resource
+// is the last collection of the path, a trailing singular word following an
+// ID is an action on the item.
+func newAPIOperation(req *http.Request) apiOperation {
+	var op apiOperation
+
+	path := strings.TrimSuffix(strings.Trim(req.URL.Path, "/"), apiFormat)
+	segs := strings.Split(path, "/")
+
+	route := make([]string, len(segs))
+	numeric := make([]bool, len(segs))
+	for i, seg := range segs {
+		route[i] = seg
+		if id, err := strconv.Atoi(seg); err == nil {
+			op.IDs = append(op.IDs, id)
+			route[i] = ":id"
+			numeric[i] = true
+		}
+	}
+	op.Route = strings.Join(route, "/")
+
+	last := len(segs) - 1
+	resource, item, action := last, false, ""
+
+	switch {
+	case numeric[last]:
+		resource, item = last-1, true
+	case last > 0 && numeric[last-1] && !strings.HasSuffix(segs[last], "s"):
+		resource, action = last-2, segs[last]
+	}
+
+	op.Service = "Client"
+	if resource >= 0 && !numeric[resource] {
+		op.Service = camelCase(segs[resource])
+	}
+
+	switch {
+	case action != "":
+		op.Method = camelCase(action)
+	case req.Method == http.MethodGet && item:
+		op.Method = "Get"
+	case req.Method == http.MethodGet:
+		op.Method = "List"
+	case req.Method == http.MethodPost:
+		op.Method = "Create"
+	case req.Method == http.MethodPut || req.Method == http.MethodPatch:
+		op.Method = "Edit"
+	case req.Method == http.MethodDelete:
+		op.Method = "Delete"
+	default:
+		op.Method = camelCase(strings.ToLower(req.Method))
+	}
+
+	return op
+}
+
+// initialisms are written in upper case in the names of the services.
+var initialisms = []string{"cpu", "fqdn", "id", "io", "ip", "iso", "ssh", "vip"}
+
+// camelCase converts snake_case API names to CamelCase.
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i, p := range parts {
+		switch {
+		case p == "":
+		case StringInSlice(initialisms, p, false):
+			parts[i] = strings.ToUpper(p)
+		default:
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
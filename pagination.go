@@ -0,0 +1,255 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+)
+
+// defaultPerPage is the number of results requested per page when the
+// PaginationOptions don't set it, so the last page can be told by its size
+// when OnApp doesn't report the pagination.
+const defaultPerPage = 100
+
+// ErrStopIteration can be returned by the ForEach callback to stop the
+// iteration without an error.
+var ErrStopIteration = errors.New("stop iteration")
+
+// ListFunc is the signature of the List methods which support pagination,
+// for example VirtualMachinesService.List.
+type ListFunc[T any] func(context.Context, *ListOptions) ([]T, *Response, error)
+
+// BindList adapts a List method with one extra argument, for example
+// UserWhiteListsService.List or VirtualMachinesService.Disks, to a ListFunc.
+func BindList[T, A any](list func(context.Context, A, *ListOptions) ([]T, *Response, error), arg A) ListFunc[T] {
+	return func(ctx context.Context, opt *ListOptions) ([]T, *Response, error) {
+		return list(ctx, arg, opt)
+	}
+}
+
+// PaginationOptions specifies the optional parameters to the pagination
+// helpers.
+type PaginationOptions struct {
+	// Number of results to request per page. Defaults to 100.
+	PerPage int
+
+	// Maximum number of pages to fetch. Zero means all pages.
+	MaxPages int
+
+	// Number of pages fetched concurrently ahead of the consumer once the
+	// number of pages is known. Zero or one fetches pages one by one.
+	Prefetch int
+}
+
+type pageResult[T any] struct {
+	items []T
+	err   error
+}
+
+// Iterator walks over the results of a ListFunc page by page.
+//
+//	it := NewIterator(ctx, client.VirtualMachines.List, nil)
+//	defer it.Close()
+//	for it.Next() {
+//		vm := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type Iterator[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	list   ListFunc[T]
+	opts   PaginationOptions
+
+	items []T
+	idx   int
+	cur   T
+	err   error
+
+	// last fetched page, and the number of pages when OnApp reports it
+	page     int
+	numPages int
+	done     bool
+
+	// ordered results of the prefetched pages
+	prefetched chan chan pageResult[T]
+	sem        chan struct{}
+}
+
+// NewIterator returns an Iterator over all pages of list. The Iterator must be
+// closed if it isn't drained, to stop prefetching.
+func NewIterator[T any](ctx context.Context, list ListFunc[T], opts *PaginationOptions) *Iterator[T] {
+	ctx, cancel := context.WithCancel(ctx)
+
+	it := &Iterator[T]{ctx: ctx, cancel: cancel, list: list}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.PerPage <= 0 {
+		it.opts.PerPage = defaultPerPage
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next result. It returns false when there
+// are no more results or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	for it.idx >= len(it.items) {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		it.items, it.err = it.nextPage()
+		it.idx = 0
+	}
+
+	it.cur = it.items[it.idx]
+	it.idx++
+
+	return true
+}
+
+// Value returns the current result.
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and cancels the pending requests.
+func (it *Iterator[T]) Close() {
+	it.done = true
+	it.cancel()
+}
+
+func (it *Iterator[T]) lastPage(page int) bool {
+	if it.opts.MaxPages > 0 && page >= it.opts.MaxPages {
+		return true
+	}
+
+	return it.numPages > 0 && page >= it.numPages
+}
+
+func (it *Iterator[T]) nextPage() ([]T, error) {
+	if it.prefetched != nil {
+		return it.nextPrefetched()
+	}
+
+	it.page++
+	items, resp, err := it.list(it.ctx, &ListOptions{Page: it.page, PerPage: it.opts.PerPage})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(items) == 0:
+		it.done = true
+	case resp != nil && resp.Links != nil && resp.Links.NumPages > 0:
+		it.numPages = resp.Links.NumPages
+		it.done = it.lastPage(it.page)
+	default:
+		// OnApp didn't report the pagination, so a short page is the last one
+		it.done = it.lastPage(it.page) || len(items) < it.opts.PerPage
+	}
+
+	if !it.done && it.opts.Prefetch > 1 && it.numPages > 0 {
+		it.startPrefetch()
+	}
+
+	return items, nil
+}
+
+func (it *Iterator[T]) startPrefetch() {
+	it.prefetched = make(chan chan pageResult[T], it.opts.Prefetch)
+	it.sem = make(chan struct{}, it.opts.Prefetch)
+
+	first := it.page + 1
+	go func() {
+		defer close(it.prefetched)
+
+		for page := first; ; page++ {
+			select {
+			case it.sem <- struct{}{}:
+			case <-it.ctx.Done():
+				return
+			}
+
+			res := make(chan pageResult[T], 1)
+			go func(page int) {
+				items, _, err := it.list(it.ctx, &ListOptions{Page: page, PerPage: it.opts.PerPage})
+				res <- pageResult[T]{items: items, err: err}
+			}(page)
+
+			select {
+			case it.prefetched <- res:
+			case <-it.ctx.Done():
+				return
+			}
+
+			if it.lastPage(page) {
+				return
+			}
+		}
+	}()
+}
+
+func (it *Iterator[T]) nextPrefetched() ([]T, error) {
+	res, ok := <-it.prefetched
+	if !ok {
+		it.done = true
+		if err := it.ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	r := <-res
+	<-it.sem
+
+	it.page++
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if len(r.items) == 0 || it.lastPage(it.page) {
+		it.Close()
+	}
+
+	return r.items, nil
+}
+
+// ForEach calls fn for every result of list. The iteration stops at the first
+// error returned by fn; ErrStopIteration stops it without an error.
+func ForEach[T any](ctx context.Context, list ListFunc[T], opts *PaginationOptions, fn func(T) error) error {
+	it := NewIterator(ctx, list, opts)
+	defer it.Close()
+
+	for it.Next() {
+		if err := fn(it.Value()); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+// ListAll returns the results of all pages of list.
+func ListAll[T any](ctx context.Context, list ListFunc[T], opts *PaginationOptions) ([]T, error) {
+	var all []T
+
+	err := ForEach(ctx, list, opts, func(v T) error {
+		all = append(all, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return all, nil
+}
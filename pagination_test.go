@@ -0,0 +1,139 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// handlePagedUsers serves total users split in pages of per_page items.
+func handlePagedUsers(t *testing.T, total int, requests *int32) {
+	mux.HandleFunc("/users.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		atomic.AddInt32(requests, 1)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		w.Header().Set(headerPage, strconv.Itoa(page))
+		w.Header().Set(headerPerPage, strconv.Itoa(perPage))
+		w.Header().Set(headerTotal, strconv.Itoa(total))
+
+		fmt.Fprint(w, "[")
+		for id := (page-1)*perPage + 1; id <= page*perPage && id <= total; id++ {
+			if id > (page-1)*perPage+1 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"user":{"id":%d}}`, id)
+		}
+		fmt.Fprint(w, "]")
+	})
+}
+
+func userIDs(users []User) []int {
+	ids := make([]int, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	return ids
+}
+
+func TestListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int32
+	handlePagedUsers(t, 6, &requests)
+
+	got, err := ListAll(ctx, client.Users.List, &PaginationOptions{PerPage: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6}, userIDs(got))
+	require.Equal(t, int32(3), requests)
+}
+
+func TestListAll_withoutPaginationHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// the server honours per_page but doesn't report the pagination
+	mux.HandleFunc("/users.json", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if perPage == 0 {
+			t.Errorf("per_page not sent")
+			perPage = 1
+		}
+
+		fmt.Fprint(w, "[")
+		for id := (page-1)*perPage + 1; id <= page*perPage && id <= 250; id++ {
+			if id > (page-1)*perPage+1 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"user":{"id":%d}}`, id)
+		}
+		fmt.Fprint(w, "]")
+	})
+
+	got, err := ListAll(ctx, client.Users.List, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 250)
+	require.Equal(t, 250, got[249].ID)
+}
+
+func TestListAll_prefetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int32
+	handlePagedUsers(t, 7, &requests)
+
+	got, err := ListAll(ctx, client.Users.List, &PaginationOptions{PerPage: 2, Prefetch: 3})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, userIDs(got))
+	require.Equal(t, int32(4), requests)
+}
+
+func TestForEach_stop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int32
+	handlePagedUsers(t, 10, &requests)
+
+	var ids []int
+	err := ForEach(ctx, client.Users.List, &PaginationOptions{PerPage: 2}, func(u User) error {
+		ids = append(ids, u.ID)
+		if u.ID == 3 {
+			return ErrStopIteration
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, ids)
+	require.Equal(t, int32(2), requests)
+}
+
+func TestResponse_populateLinks(t *testing.T) {
+	cases := []struct {
+		total, perPage, numPages int
+	}{
+		{total: 0, perPage: 10, numPages: 0},
+		{total: 10, perPage: 10, numPages: 1},
+		{total: 11, perPage: 10, numPages: 2},
+		{total: 20, perPage: 10, numPages: 2},
+	}
+
+	for _, c := range cases {
+		r := &Response{Response: &http.Response{Header: http.Header{}}}
+		r.Header.Set(headerPage, "1")
+		r.Header.Set(headerPerPage, strconv.Itoa(c.perPage))
+		r.Header.Set(headerTotal, strconv.Itoa(c.total))
+		r.populateLinks()
+
+		require.Equal(t, c.numPages, r.Links.NumPages, "total %d per page %d", c.total, c.perPage)
+	}
+}
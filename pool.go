@@ -0,0 +1,220 @@
+package onappgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ClientPool holds the clients of several Control Panels, for example one
+// per region, and queries them concurrently.
+//
+//	pool := NewClientPool(map[string]*Client{"eu": eu, "us": us})
+//	vms, err := pool.VirtualMachines(ctx, nil)
+//	for _, vm := range vms {
+//		fmt.Println(vm.Origin, vm.Item.Hostname)
+//	}
+//
+// The results of the Control Panels which answered are returned with a
+// *PoolError reporting the ones which failed.
+type ClientPool struct {
+	// Maximum number of Control Panels queried concurrently, all of them if
+	// zero
+	Concurrency int
+
+	mu      sync.RWMutex
+	clients map[string]*Client
+}
+
+// NewClientPool returns a pool of the clients, keyed by the name of their
+// Control Panel.
+func NewClientPool(clients map[string]*Client) *ClientPool {
+	p := &ClientPool{clients: make(map[string]*Client, len(clients))}
+	for name, c := range clients {
+		p.clients[name] = c
+	}
+
+	return p
+}
+
+// Add adds the client of a Control Panel to the pool, replacing the client
+// with the same name.
+func (p *ClientPool) Add(name string, c *Client) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.clients == nil {
+		p.clients = map[string]*Client{}
+	}
+	p.clients[name] = c
+}
+
+// Remove removes the client of a Control Panel from the pool.
+func (p *ClientPool) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.clients, name)
+}
+
+// Client returns the client of a Control Panel, nil if it is not in the
+// pool.
+func (p *ClientPool) Client(name string) *Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.clients[name]
+}
+
+// Names returns the sorted names of the Control Panels of the pool.
+func (p *ClientPool) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	names := make([]string, 0, len(p.clients))
+	for name := range p.clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// PoolResult is the result of a function run against a Control Panel of a
+// ClientPool.
+type PoolResult[T any] struct {
+	// Name of the Control Panel
+	Origin string
+
+	Value T
+	Err   error
+}
+
+// Tagged is an item merged from several Control Panels, with the name of
+// the one it comes from.
+type Tagged[T any] struct {
+	Origin string
+	Item   T
+}
+
+// PoolError reports the Control Panels of a ClientPool which failed.
+type PoolError struct {
+	// Errors by name of the Control Panel
+	Errors map[string]error
+}
+
+func (e *PoolError) Error() string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = fmt.Sprintf("%s: %v", name, e.Errors[name])
+	}
+
+	return fmt.Sprintf("onapp pool: %d control panel(s) failed: %s", len(names), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors, so errors.Is and errors.As match any of them.
+func (e *PoolError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// RunPool runs fn against every Control Panel of the pool, at most
+// p.Concurrency at once. The results are sorted by name of the Control
+// Panel. The Control Panels not queried yet when ctx is done get the error of
+// ctx.
+func RunPool[T any](ctx context.Context, p *ClientPool, fn func(ctx context.Context, c *Client) (T, error)) []PoolResult[T] {
+	names := p.Names()
+	results := make([]PoolResult[T], len(names))
+
+	limit := p.Concurrency
+	if limit < 1 || limit > len(names) {
+		limit = len(names)
+	}
+	sem := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		results[i].Origin = name
+		c := p.Client(name)
+		if c == nil {
+			results[i].Err = fmt.Errorf("onapp pool: control panel %q was removed", name)
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(r *PoolResult[T], c *Client) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			r.Value, r.Err = fn(ctx, c)
+		}(&results[i], c)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// CollectPool runs fn against every Control Panel of the pool and merges the
+// items it returned, tagged with their origin. The items of the Control
+// Panels which answered are returned even if others failed, with a
+// *PoolError.
+func CollectPool[T any](ctx context.Context, p *ClientPool, fn func(ctx context.Context, c *Client) ([]T, error)) ([]Tagged[T], error) {
+	var items []Tagged[T]
+	var errs map[string]error
+
+	for _, r := range RunPool(ctx, p, fn) {
+		if r.Err != nil {
+			if errs == nil {
+				errs = map[string]error{}
+			}
+			errs[r.Origin] = r.Err
+			continue
+		}
+
+		for _, item := range r.Value {
+			items = append(items, Tagged[T]{Origin: r.Origin, Item: item})
+		}
+	}
+
+	if errs != nil {
+		return items, &PoolError{Errors: errs}
+	}
+
+	return items, nil
+}
+
+// VirtualMachines lists the virtual machines of every Control Panel of the
+// pool.
+func (p *ClientPool) VirtualMachines(ctx context.Context, opts *PaginationOptions) ([]Tagged[VirtualMachine], error) {
+	return CollectPool(ctx, p, func(ctx context.Context, c *Client) ([]VirtualMachine, error) {
+		return ListAll(ctx, c.VirtualMachines.List, opts)
+	})
+}
+
+// Hypervisors lists the hypervisors of every Control Panel of the pool.
+func (p *ClientPool) Hypervisors(ctx context.Context, opts *PaginationOptions) ([]Tagged[Hypervisor], error) {
+	return CollectPool(ctx, p, func(ctx context.Context, c *Client) ([]Hypervisor, error) {
+		return ListAll(ctx, c.Hypervisors.List, opts)
+	})
+}
@@ -0,0 +1,55 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPool_VirtualMachines(t *testing.T) {
+	newServer := func(hostnames ...string) *Client {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if hostnames == nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			fmt.Fprint(w, "[")
+			for i, hostname := range hostnames {
+				if i > 0 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprintf(w, `{"virtual_machine": {"id": %d, "hostname": %q}}`, i+1, hostname)
+			}
+			fmt.Fprint(w, "]")
+		}))
+		t.Cleanup(srv.Close)
+
+		c, err := New(nil, SetBaseURL(srv.URL))
+		require.NoError(t, err)
+		return c
+	}
+
+	pool := NewClientPool(map[string]*Client{
+		"eu": newServer("web", "db"),
+		"us": newServer("cache"),
+	})
+	pool.Add("ap", newServer())
+	pool.Concurrency = 2
+
+	vms, err := pool.VirtualMachines(ctx, nil)
+
+	var poolErr *PoolError
+	require.ErrorAs(t, err, &poolErr)
+	require.Len(t, poolErr.Errors, 1)
+	require.True(t, IsServerError(poolErr.Errors["ap"]))
+
+	var got []string
+	for _, vm := range vms {
+		got = append(got, vm.Origin+"/"+vm.Item.Hostname)
+	}
+	require.Equal(t, []string{"eu/web", "eu/db", "us/cache"}, got)
+}
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -66,6 +65,10 @@ func (s *RateCardsServiceOp) List(ctx context.Context, id int, opt *ListOptions)
 		return nil, nil, godo.NewArgError("id", "cannot be less than 1")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureRateCards); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf(bucketRateCardsBasePath, id) + apiFormat
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -93,13 +96,16 @@ func (s *RateCardsServiceOp) Create(ctx context.Context, createRequest *RateCard
 		return nil, nil, godo.NewArgError("createRequest", "cannot be nil")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureRateCards); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf(bucketRateCardsBasePath, createRequest.BucketID) + apiFormat
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createRequest)
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("RateCard [Create]  req: ", req)
 
 	root := new(rateCardRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -116,6 +122,10 @@ func (s *RateCardsServiceOp) Delete(ctx context.Context, deleteRequest *RateCard
 		return nil, godo.NewArgError("bucket_id", "cannot be less than 1")
 	}
 
+	if err := s.client.requireFeature(ctx, FeatureRateCards); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf(bucketRateCardsBasePath, deleteRequest.BucketID) + apiFormat
 	path, err := addOptions(path, meta)
 	if err != nil {
@@ -126,7 +136,6 @@ func (s *RateCardsServiceOp) Delete(ctx context.Context, deleteRequest *RateCard
 	if err != nil {
 		return nil, err
 	}
-	log.Println("RateCard [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
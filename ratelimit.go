@@ -0,0 +1,242 @@
+package onappgo
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit configures the client side throttling of the requests sent to
+// one OnApp host. The limits are shared by all services of the Client.
+type RateLimit struct {
+	// Sustained number of requests per second. Zero means unlimited.
+	RequestsPerSecond float64
+
+	// Number of requests which can be sent at once above the sustained rate.
+	// Defaults to the rate rounded up.
+	Burst int
+
+	// Maximum number of requests in flight. Zero means unlimited.
+	MaxInFlight int
+}
+
+// RateLimitStats reports the saturation of the throttling of one host.
+type RateLimitStats struct {
+	Host string
+
+	// Configured limits
+	RequestsPerSecond float64
+	MaxInFlight       int
+
+	// Requests being sent or waiting for the response
+	InFlight int
+
+	// Requests waiting for a token or a free slot
+	Waiting int
+
+	// Tokens currently available for new requests
+	Tokens float64
+}
+
+type hostThrottle struct {
+	limit   RateLimit
+	limiter *rate.Limiter
+	slots   chan struct{}
+
+	inFlight int64
+	waiting  int64
+}
+
+type throttle struct {
+	mu        sync.Mutex
+	limit     *RateLimit
+	overrides map[string]RateLimit
+	hosts     map[string]*hostThrottle
+}
+
+// SetRateLimit is a client option for throttling the requests sent to every
+// host without its own limit set by SetHostRateLimit.
+func SetRateLimit(l RateLimit) ClientOpt {
+	return func(c *Client) error {
+		t := c.throttler()
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		t.limit = &l
+		t.hosts = make(map[string]*hostThrottle)
+		return nil
+	}
+}
+
+// SetHostRateLimit is a client option for throttling the requests sent to the
+// host, given as host or host:port like in URL.Host.
+func SetHostRateLimit(host string, l RateLimit) ClientOpt {
+	return func(c *Client) error {
+		t := c.throttler()
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		t.overrides[host] = l
+		delete(t.hosts, host)
+		return nil
+	}
+}
+
+// RateLimitStats returns the current saturation of the throttled hosts,
+// sorted by host.
+func (c *Client) RateLimitStats() []RateLimitStats {
+	if c.throttling == nil {
+		return nil
+	}
+
+	t := c.throttling
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := make([]RateLimitStats, 0, len(t.hosts))
+	for host, h := range t.hosts {
+		if h == nil {
+			continue
+		}
+
+		s := RateLimitStats{
+			Host:              host,
+			RequestsPerSecond: h.limit.RequestsPerSecond,
+			MaxInFlight:       h.limit.MaxInFlight,
+			InFlight:          int(atomic.LoadInt64(&h.inFlight)),
+			Waiting:           int(atomic.LoadInt64(&h.waiting)),
+		}
+		if h.limiter != nil {
+			s.Tokens = h.limiter.Tokens()
+		}
+		stats = append(stats, s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Host < stats[j].Host })
+	return stats
+}
+
+func (c *Client) throttler() *throttle {
+	if c.throttling == nil {
+		c.throttling = &throttle{
+			overrides: make(map[string]RateLimit),
+			hosts:     make(map[string]*hostThrottle),
+		}
+	}
+
+	return c.throttling
+}
+
+func (t *throttle) host(host string) *hostThrottle {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if h, ok := t.hosts[host]; ok {
+		return h
+	}
+
+	l, ok := t.overrides[host]
+	if !ok {
+		if t.limit == nil {
+			t.hosts[host] = nil
+			return nil
+		}
+		l = *t.limit
+	}
+
+	h := &hostThrottle{limit: l}
+	if l.RequestsPerSecond > 0 {
+		burst := l.Burst
+		if burst < 1 {
+			burst = int(math.Ceil(l.RequestsPerSecond))
+		}
+		h.limiter = rate.NewLimiter(rate.Limit(l.RequestsPerSecond), burst)
+	}
+	if l.MaxInFlight > 0 {
+		h.slots = make(chan struct{}, l.MaxInFlight)
+	}
+
+	t.hosts[host] = h
+	return h
+}
+
+// acquire blocks until the request is allowed to be sent. The returned
+// function must be called once the request is done.
+func (t *throttle) acquire(ctx context.Context, req *http.Request) (func(), error) {
+	h := t.host(req.URL.Host)
+	if h == nil {
+		return func() {}, nil
+	}
+
+	atomic.AddInt64(&h.waiting, 1)
+	defer atomic.AddInt64(&h.waiting, -1)
+
+	if h.slots != nil {
+		select {
+		case h.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if h.limiter != nil {
+		if err := h.limiter.Wait(ctx); err != nil {
+			if h.slots != nil {
+				<-h.slots
+			}
+			return nil, err
+		}
+	}
+
+	atomic.AddInt64(&h.inFlight, 1)
+	return func() {
+		atomic.AddInt64(&h.inFlight, -1)
+		if h.slots != nil {
+			<-h.slots
+		}
+	}, nil
+}
+
+// throttleMiddleware waits until the request is allowed by the rate limits of
+// its host. The request is in flight until its response body is closed.
+func (c *Client) throttleMiddleware(next RoundTripFunc) RoundTripFunc {
+	if c.throttling == nil {
+		return next
+	}
+
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		release, err := c.throttling.acquire(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			release()
+			return nil, err
+		}
+
+		resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+		return resp, nil
+	}
+}
+
+// releasingBody calls release once the body is closed.
+type releasingBody struct {
+	io.ReadCloser
+
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,118 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// doConcurrently sends n GET requests to the path at once and returns their
+// errors once they are all done.
+func doConcurrently(n int, path string) []error {
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+			if err == nil {
+				_, err = client.Do(ctx, req, nil)
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestDo_maxInFlight(t *testing.T) {
+	setup()
+	defer teardown()
+
+	require.NoError(t, SetRateLimit(RateLimit{MaxInFlight: 2})(client))
+
+	// the requests are held by the server until released
+	var cur, max int32
+	arrived := make(chan struct{}, 5)
+	release := make(chan struct{})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&cur, 1)
+		defer atomic.AddInt32(&cur, -1)
+
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+
+		arrived <- struct{}{}
+		<-release
+		fmt.Fprint(w, `{}`)
+	})
+
+	done := make(chan []error)
+	go func() {
+		done <- doConcurrently(5, "/")
+	}()
+
+	<-arrived
+	<-arrived
+
+	// the other requests wait for a free slot
+	require.Eventually(t, func() bool {
+		stats := client.RateLimitStats()
+		return len(stats) == 1 && stats[0].InFlight == 2 && stats[0].Waiting == 3
+	}, time.Second, time.Millisecond)
+
+	select {
+	case <-arrived:
+		t.Fatal("a third request was sent while two were in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	for _, err := range <-done {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(2), max)
+	require.Len(t, arrived, 3)
+
+	stats := client.RateLimitStats()
+	require.Len(t, stats, 1)
+	require.Equal(t, client.BaseURL.Host, stats[0].Host)
+	require.Equal(t, 2, stats[0].MaxInFlight)
+	require.Equal(t, 0, stats[0].InFlight)
+	require.Equal(t, 0, stats[0].Waiting)
+}
+
+func TestDo_requestsPerSecond(t *testing.T) {
+	setup()
+	defer teardown()
+
+	require.NoError(t, SetRateLimit(RateLimit{RequestsPerSecond: 20, Burst: 1})(client))
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	// the first request takes the burst, the others a token every 50ms
+	startedAt := time.Now()
+	for _, err := range doConcurrently(4, "/") {
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(startedAt), 140*time.Millisecond)
+
+	stats := client.RateLimitStats()
+	require.Len(t, stats, 1)
+	require.Equal(t, float64(20), stats[0].RequestsPerSecond)
+	require.Less(t, stats[0].Tokens, float64(1))
+}
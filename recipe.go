@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -126,7 +125,6 @@ func (s *RecipesServiceOp) Create(ctx context.Context, createRequest *RecipeCrea
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("Recipe [Create] req: ", req)
 
 	root := new(recipeRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -153,7 +151,6 @@ func (s *RecipesServiceOp) Delete(ctx context.Context, id int, meta interface{})
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Recipe [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -174,7 +171,6 @@ func (s *RecipesServiceOp) Edit(ctx context.Context, id int, editRequest *Recipe
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Recipe [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -174,7 +173,6 @@ func (s *RecipeGroupsServiceOp) Create(ctx context.Context, createRequest *Recip
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("RecipeGroup [Create] req: ", req)
 
 	root := new(recipeGroupRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -201,7 +199,6 @@ func (s *RecipeGroupsServiceOp) Delete(ctx context.Context, id int, meta interfa
 	if err != nil {
 		return nil, err
 	}
-	log.Println("RecipeGroup [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -222,7 +219,6 @@ func (s *RecipeGroupsServiceOp) Edit(ctx context.Context, id int, editRequest *R
 	if err != nil {
 		return nil, err
 	}
-	log.Println("RecipeGroup [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -242,7 +238,6 @@ func (s *RecipeGroupsServiceOp) Attach(ctx context.Context, recipeGroupID int, a
 	if err != nil {
 		return nil, err
 	}
-	log.Println("RecipeGroup [Attach]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -260,7 +255,6 @@ func (s *RecipeGroupsServiceOp) Detach(ctx context.Context, recipeGroupID, recip
 	if err != nil {
 		return nil, err
 	}
-	log.Println("RecipeGroup [Detach]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -277,7 +271,6 @@ func (s *RecipeGroupsServiceOp) Recipes(ctx context.Context, recipeGroupID int,
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("RecipeGroup [Recipes]  req: ", req)
 
 	var out []map[string]Recipe
 	resp, err := s.client.Do(ctx, req, &out)
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -138,7 +137,6 @@ func (s *RecipeJoinsServiceOp) Create(ctx context.Context, createRequest *Recipe
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("RecipeJoin [Create] req: ", req)
 
 	root := new(recipeJoinRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -181,7 +179,6 @@ func (s *RecipeJoinsServiceOp) Delete(ctx context.Context, deleteRequest *Recipe
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("RecipeJoin [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
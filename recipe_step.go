@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -147,7 +146,6 @@ func (s *RecipeStepsServiceOp) Create(ctx context.Context, recipeID int, createR
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("RecipeStep [Create] req: ", req)
 
 	root := new(recipeStepRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -175,7 +173,6 @@ func (s *RecipeStepsServiceOp) Delete(ctx context.Context, recipeID int, recipeS
 	if err != nil {
 		return nil, err
 	}
-	log.Println("RecipeStep [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -198,7 +195,6 @@ func (s *RecipeStepsServiceOp) Swap(ctx context.Context, recipeID int, recipeSte
 	if err != nil {
 		return nil, err
 	}
-	log.Println("RecipeStep [Swap] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
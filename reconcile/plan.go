@@ -0,0 +1,584 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+// StepKind is the kind of change made by a step of a plan.
+type StepKind string
+
+// Kinds of the steps, in the order they are planned
+const (
+	CreateVirtualMachine StepKind = "create virtual machine"
+	ResizeVirtualMachine StepKind = "resize virtual machine"
+	ResizeDisk           StepKind = "resize disk"
+	AddDisk              StepKind = "add disk"
+	AddNetworkInterface  StepKind = "add network interface"
+	AssignIPAddress      StepKind = "assign IP address"
+	AddFirewallRule      StepKind = "add firewall rule"
+	JoinRecipe           StepKind = "join recipe"
+)
+
+// Step is a change of the virtual machine.
+type Step struct {
+	Kind StepKind
+
+	// Description of the change, for a review of the plan
+	Description string
+
+	apply func(ctx context.Context, a *applier) error
+}
+
+func (s Step) String() string {
+	return string(s.Kind) + ": " + s.Description
+}
+
+// Plan is the ordered list of the changes converging a virtual machine to its
+// spec.
+type Plan struct {
+	// VirtualMachineID is zero when the plan creates the virtual machine
+	VirtualMachineID int
+
+	Steps []Step
+
+	spec *Spec
+}
+
+// Empty reports whether the virtual machine already matches its spec.
+func (p *Plan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "no changes\n"
+	}
+
+	var b strings.Builder
+	for i, step := range p.Steps {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, step)
+	}
+
+	return b.String()
+}
+
+func (p *Plan) add(kind StepKind, apply func(ctx context.Context, a *applier) error, format string, args ...interface{}) {
+	p.Steps = append(p.Steps, Step{
+		Kind:        kind,
+		Description: fmt.Sprintf(format, args...),
+		apply:       apply,
+	})
+}
+
+// state is the current state of the virtual machine.
+type state struct {
+	// vm is nil when the virtual machine is created by the plan
+	vm *onappgo.VirtualMachine
+
+	disks      []onappgo.Disk
+	interfaces []onappgo.NetworkInterface
+
+	// addresses are the IP addresses, an empty one is assigned at the
+	// creation without being known yet
+	addresses []string
+	rules     []onappgo.FirewallRule
+	recipes   []onappgo.RecipeJoin
+}
+
+// Plan compares the spec with the virtual machine of the id, zero when it
+// doesn't exist yet, and returns the steps converging it to the spec. Nothing
+// is ever removed: the disks, network interfaces, IP addresses, firewall
+// rules and recipes missing from the spec are left as they are.
+func (r *Reconciler) Plan(ctx context.Context, id int, spec *Spec) (*Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{VirtualMachineID: id, spec: spec}
+
+	var cur *state
+	if id == 0 {
+		if err := planCreate(plan, spec); err != nil {
+			return nil, err
+		}
+		cur = createdState(spec)
+	} else {
+		var err error
+		if cur, err = r.current(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	planResize(plan, spec, cur)
+	if err := planDisks(plan, spec, cur); err != nil {
+		return nil, err
+	}
+	if err := planInterfaces(plan, spec, cur); err != nil {
+		return nil, err
+	}
+	planAddresses(plan, spec, cur)
+	planFirewallRules(plan, spec, cur)
+	planRecipes(plan, spec, cur)
+
+	return plan, nil
+}
+
+// current reads the state of the virtual machine.
+func (r *Reconciler) current(ctx context.Context, id int) (*state, error) {
+	vm, _, err := r.client.VirtualMachines.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cur := &state{vm: vm}
+	for _, ip := range vm.IPAddresses {
+		cur.addresses = append(cur.addresses, ip.IPAddress.Address)
+	}
+
+	if cur.disks, _, err = r.client.VirtualMachines.Disks(ctx, id, nil); err != nil {
+		return nil, err
+	}
+
+	if cur.interfaces, _, err = r.client.VirtualMachines.ListNetworkInterfaces(ctx, id, nil); err != nil {
+		return nil, err
+	}
+
+	if cur.rules, _, err = r.client.VirtualMachines.ListFirewallRules(ctx, id, nil); err != nil {
+		return nil, err
+	}
+
+	joins, _, err := r.client.RecipeJoins.List(ctx, &onappgo.RecipeJoinCreateRequest{
+		TargetJoinID:   id,
+		TargetJoinType: "VirtualMachine",
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	cur.recipes = recipeJoins(joins)
+
+	return cur, nil
+}
+
+// recipeJoins finds the recipe joins in the decoded list, which groups them
+// by event type.
+func recipeJoins(v interface{}) []onappgo.RecipeJoin {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if _, ok := v["recipe_id"]; ok {
+			var join onappgo.RecipeJoin
+			if data, err := json.Marshal(v); err == nil && json.Unmarshal(data, &join) == nil {
+				return []onappgo.RecipeJoin{join}
+			}
+			return nil
+		}
+
+		var joins []onappgo.RecipeJoin
+		for _, e := range v {
+			joins = append(joins, recipeJoins(e)...)
+		}
+		return joins
+
+	case []interface{}:
+		var joins []onappgo.RecipeJoin
+		for _, e := range v {
+			joins = append(joins, recipeJoins(e)...)
+		}
+		return joins
+	}
+
+	return nil
+}
+
+func planCreate(plan *Plan, spec *Spec) error {
+	var missing []string
+	if spec.Label == "" {
+		missing = append(missing, "label")
+	}
+	if spec.Hostname == "" {
+		missing = append(missing, "hostname")
+	}
+	if spec.TemplateID == 0 {
+		missing = append(missing, "template_id")
+	}
+	if spec.Cpus == 0 {
+		missing = append(missing, "cpus")
+	}
+	if spec.Memory == 0 {
+		missing = append(missing, "memory")
+	}
+	if spec.primaryDisk() < 0 {
+		missing = append(missing, "a primary disk")
+	}
+	if len(missing) > 0 {
+		return &SpecError{Problems: []string{"creating the virtual machine needs " + strings.Join(missing, ", ")}}
+	}
+
+	req := &onappgo.VirtualMachineCreateRequest{
+		Label:                         spec.Label,
+		Hostname:                      spec.Hostname,
+		TemplateID:                    spec.TemplateID,
+		Cpus:                          spec.Cpus,
+		CPUShares:                     spec.CPUShares,
+		Memory:                        spec.Memory,
+		PrimaryDiskSize:               spec.Disks[spec.primaryDisk()].Size,
+		RequiredVirtualMachineBuild:   true,
+		RequiredVirtualMachineStartup: true,
+	}
+	if i := spec.swapDisk(); i >= 0 {
+		req.SwapDiskSize = spec.Disks[i].Size
+	}
+	if i := spec.primaryInterface(); i >= 0 {
+		nic := spec.NetworkInterfaces[i]
+		req.NetworkID = nic.NetworkID
+		if len(nic.IPAddresses) > 0 {
+			req.SelectedIPAddress = nic.IPAddresses[0].Address
+		}
+	}
+
+	plan.add(CreateVirtualMachine, func(ctx context.Context, a *applier) error {
+		// the build queues its transactions as it goes, the next steps need
+		// the virtual machine built and unlocked
+		build, _, err := a.client.VirtualMachines.CreateAndWait(ctx, req, &onappgo.VirtualMachineBuildOptions{Wait: a.WaitOptions})
+		if build != nil {
+			a.vmID = build.VirtualMachine.ID
+		}
+
+		return err
+	}, "%s (%d CPUs, %d MB of memory, %d GB disk)", spec.Label, spec.Cpus, spec.Memory, req.PrimaryDiskSize)
+
+	return nil
+}
+
+// createdState is the state of the virtual machine once created by
+// planCreate.
+func createdState(spec *Spec) *state {
+	cur := new(state)
+
+	primary := spec.Disks[spec.primaryDisk()]
+	cur.disks = append(cur.disks, onappgo.Disk{Label: primary.Label, DiskSize: primary.Size, Primary: true})
+	if i := spec.swapDisk(); i >= 0 {
+		cur.disks = append(cur.disks, onappgo.Disk{Label: spec.Disks[i].Label, DiskSize: spec.Disks[i].Size, IsSwap: true})
+	}
+
+	if i := spec.primaryInterface(); i >= 0 {
+		nic := spec.NetworkInterfaces[i]
+		cur.interfaces = append(cur.interfaces, onappgo.NetworkInterface{Label: nic.Label, Primary: true})
+
+		if len(nic.IPAddresses) > 0 {
+			cur.addresses = append(cur.addresses, nic.IPAddresses[0].Address)
+		} else if nic.NetworkID != 0 {
+			cur.addresses = append(cur.addresses, "")
+		}
+	}
+
+	return cur
+}
+
+func planResize(plan *Plan, spec *Spec, cur *state) {
+	if cur.vm == nil {
+		return
+	}
+
+	vm := cur.vm
+	req := new(onappgo.VirtualMachineEditRequest)
+	var changes []string
+
+	if spec.Label != "" && spec.Label != vm.Label {
+		req.Label = spec.Label
+		changes = append(changes, fmt.Sprintf("label %q -> %q", vm.Label, spec.Label))
+	}
+	if spec.Cpus != 0 && spec.Cpus != vm.Cpus {
+		req.Cpus = spec.Cpus
+		changes = append(changes, fmt.Sprintf("CPUs %d -> %d", vm.Cpus, spec.Cpus))
+	}
+	if spec.CPUShares != 0 && spec.CPUShares != vm.CPUShares {
+		req.CPUShares = spec.CPUShares
+		changes = append(changes, fmt.Sprintf("CPU shares %d -> %d", vm.CPUShares, spec.CPUShares))
+	}
+	if spec.Memory != 0 && spec.Memory != vm.Memory {
+		req.Memory = spec.Memory
+		changes = append(changes, fmt.Sprintf("memory %d MB -> %d MB", vm.Memory, spec.Memory))
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+
+	plan.add(ResizeVirtualMachine, func(ctx context.Context, a *applier) error {
+		// the transactions of the step are waited for by the applier
+		_, _, err := a.client.VirtualMachines.Edit(ctx, a.vmID, req)
+		if errors.Is(err, onappgo.ErrTransactionNotFound) {
+			return nil
+		}
+		return err
+	}, "%s", strings.Join(changes, ", "))
+}
+
+// matchDisk returns the current disk of the spec, nil if there is none.
+func matchDisk(spec *Spec, i int, disks []onappgo.Disk) *onappgo.Disk {
+	d := spec.Disks[i]
+	for j := range disks {
+		cur := &disks[j]
+		switch {
+		case i == spec.primaryDisk():
+			if cur.Primary {
+				return cur
+			}
+		case d.Swap:
+			if cur.IsSwap {
+				return cur
+			}
+		default:
+			if !cur.Primary && !cur.IsSwap && cur.Label == d.Label {
+				return cur
+			}
+		}
+	}
+
+	return nil
+}
+
+func planDisks(plan *Plan, spec *Spec, cur *state) error {
+	for i, d := range spec.Disks {
+		d := d
+		name := diskName(spec, i)
+
+		existing := matchDisk(spec, i, cur.disks)
+		if existing == nil {
+			plan.add(AddDisk, func(ctx context.Context, a *applier) error {
+				_, _, err := a.client.Disks.Create(ctx, &onappgo.DiskCreateRequest{
+					VirtualMachineID:  a.vmID,
+					Label:             d.Label,
+					DiskSize:          d.Size,
+					DataStoreID:       d.DataStoreID,
+					FileSystem:        d.FileSystem,
+					MountPoint:        d.MountPoint,
+					AddToLinuxFstab:   d.MountPoint != "",
+					Mounted:           d.MountPoint != "",
+					RequireFormatDisk: true,
+				})
+				return err
+			}, "%s (%d GB)", name, d.Size)
+			continue
+		}
+
+		switch {
+		case existing.DiskSize > d.Size:
+			return fmt.Errorf("reconcile: %s can't shrink from %d GB to %d GB", name, existing.DiskSize, d.Size)
+		case existing.DiskSize < d.Size:
+			id := existing.ID
+			plan.add(ResizeDisk, func(ctx context.Context, a *applier) error {
+				_, err := a.client.Disks.Edit(ctx, id, &onappgo.DiskEditRequest{DiskSize: d.Size})
+				return err
+			}, "%s %d GB -> %d GB", name, existing.DiskSize, d.Size)
+		}
+	}
+
+	return nil
+}
+
+func diskName(spec *Spec, i int) string {
+	switch d := spec.Disks[i]; {
+	case i == spec.primaryDisk():
+		return "primary disk"
+	case d.Swap:
+		return "swap disk"
+	default:
+		return fmt.Sprintf("disk %q", d.Label)
+	}
+}
+
+// matchInterface returns the current network interface of the spec, nil if
+// there is none.
+func matchInterface(spec *Spec, i int, interfaces []onappgo.NetworkInterface) *onappgo.NetworkInterface {
+	for j := range interfaces {
+		cur := &interfaces[j]
+		if i == spec.primaryInterface() {
+			if cur.Primary {
+				return cur
+			}
+		} else if !cur.Primary && cur.Label == spec.NetworkInterfaces[i].Label {
+			return cur
+		}
+	}
+
+	return nil
+}
+
+func planInterfaces(plan *Plan, spec *Spec, cur *state) error {
+	for i, nic := range spec.NetworkInterfaces {
+		if matchInterface(spec, i, cur.interfaces) != nil {
+			continue
+		}
+
+		if nic.NetworkJoinID == 0 {
+			return fmt.Errorf("reconcile: network interface %q is missing and has no network_join_id", nic.Label)
+		}
+
+		req := &onappgo.NetworkInterfaceCreateRequest{
+			Label:         nic.Label,
+			NetworkJoinID: nic.NetworkJoinID,
+			RateLimit:     nic.RateLimit,
+		}
+		plan.add(AddNetworkInterface, func(ctx context.Context, a *applier) error {
+			_, _, err := a.client.NetworkInterfaces.Create(ctx, a.vmID, req)
+			return err
+		}, "%s", nic.Label)
+	}
+
+	return nil
+}
+
+func planAddresses(plan *Plan, spec *Spec, cur *state) {
+	// the addresses not claimed by a spec yet, a spec without address claims
+	// any of them
+	unclaimed := append([]string(nil), cur.addresses...)
+	claim := func(address string) bool {
+		for i, a := range unclaimed {
+			if a == address || address == "" {
+				unclaimed = append(unclaimed[:i], unclaimed[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+
+	type missing struct {
+		nic int
+		ip  IPAddressSpec
+	}
+	var assign []missing
+
+	// the known addresses are claimed first
+	for pass := 0; pass < 2; pass++ {
+		for i, nic := range spec.NetworkInterfaces {
+			for _, ip := range nic.IPAddresses {
+				if (pass == 0) != (ip.Address != "") {
+					continue
+				}
+
+				if !claim(ip.Address) {
+					assign = append(assign, missing{i, ip})
+				}
+			}
+		}
+	}
+
+	for _, m := range assign {
+		nic, ip := m.nic, m.ip
+		what := "any address"
+		if ip.Address != "" {
+			what = ip.Address
+		}
+
+		plan.add(AssignIPAddress, func(ctx context.Context, a *applier) error {
+			nicID, err := a.interfaceID(ctx, nic)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = a.client.VirtualMachineActions.AssignIPAddress(ctx, a.vmID, map[string]interface{}{
+				"ip_address": &onappgo.AssignIPAddress{
+					Address:            ip.Address,
+					IPNetID:            ip.IPNetID,
+					IPRangeID:          ip.IPRangeID,
+					NetworkInterfaceID: nicID,
+				},
+			})
+			return err
+		}, "%s to %s", what, spec.NetworkInterfaces[nic].Label)
+	}
+}
+
+// ruleInterface returns the index of the network interface of the rule.
+func ruleInterface(spec *Spec, rule FirewallRuleSpec) int {
+	if rule.Interface == "" {
+		return spec.primaryInterface()
+	}
+
+	for i, nic := range spec.NetworkInterfaces {
+		if nic.Label == rule.Interface {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func sameRule(cur onappgo.FirewallRule, rule FirewallRuleSpec) bool {
+	return strings.EqualFold(cur.Command, rule.Command) &&
+		strings.EqualFold(cur.Protocol, rule.Protocol) &&
+		cur.Address == rule.Address &&
+		cur.Port == rule.Port
+}
+
+func planFirewallRules(plan *Plan, spec *Spec, cur *state) {
+	for _, rule := range spec.FirewallRules {
+		rule := rule
+		nic := ruleInterface(spec, rule)
+
+		found := false
+		if existing := matchInterface(spec, nic, cur.interfaces); existing != nil && existing.ID != 0 {
+			for _, r := range cur.rules {
+				if r.NetworkInterfaceID == existing.ID && sameRule(r, rule) {
+					found = true
+					break
+				}
+			}
+		}
+		if found {
+			continue
+		}
+
+		what := strings.TrimSpace(strings.Join([]string{rule.Command, rule.Protocol, rule.Address, rule.Port}, " "))
+		plan.add(AddFirewallRule, func(ctx context.Context, a *applier) error {
+			nicID, err := a.interfaceID(ctx, nic)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = a.client.FirewallRules.Create(ctx, a.vmID, &onappgo.FirewallRuleCreateRequest{
+				Address:            rule.Address,
+				Command:            rule.Command,
+				Protocol:           rule.Protocol,
+				NetworkInterfaceID: nicID,
+				Comment:            rule.Comment,
+				Port:               rule.Port,
+			})
+			return err
+		}, "%s on %s", what, spec.NetworkInterfaces[nic].Label)
+	}
+}
+
+func planRecipes(plan *Plan, spec *Spec, cur *state) {
+	for _, recipe := range spec.Recipes {
+		recipe := recipe
+
+		found := false
+		for _, join := range cur.recipes {
+			if join.RecipeID == recipe.RecipeID && join.EventType == recipe.EventType {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		plan.add(JoinRecipe, func(ctx context.Context, a *applier) error {
+			_, _, err := a.client.RecipeJoins.Create(ctx, &onappgo.RecipeJoinCreateRequest{
+				EventType:      recipe.EventType,
+				RecipeID:       recipe.RecipeID,
+				TargetJoinID:   a.vmID,
+				TargetJoinType: "VirtualMachine",
+			})
+			return err
+		}, "recipe %d on %s", recipe.RecipeID, recipe.EventType)
+	}
+}
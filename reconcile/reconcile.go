@@ -0,0 +1,143 @@
+// Package reconcile converges virtual machines to declarative specs.
+//
+// A spec describes the label, hostname, template, CPUs, memory, disks,
+// network interfaces with their IP addresses, firewall rules and recipes of
+// a virtual machine, usually in a YAML file:
+//
+//	label: web
+//	hostname: web
+//	template_id: 12
+//	cpus: 2
+//	memory: 2048
+//	disks:
+//	  - label: root
+//	    size: 20
+//	  - label: data
+//	    size: 50
+//	    mount_point: /data
+//	network_interfaces:
+//	  - label: eth0
+//	    network_id: 3
+//	firewall_rules:
+//	  - command: ACCEPT
+//	    protocol: TCP
+//	    port: "22"
+//
+// The plan compares the spec with the current state of the virtual machine,
+// and apply makes the changes in order, waiting for their transactions:
+//
+//	spec, err := reconcile.LoadSpec("web.yaml")
+//	...
+//	r := reconcile.New(client)
+//	plan, err := r.Plan(ctx, vmID, spec)
+//	...
+//	fmt.Print(plan)
+//	vmID, err = r.Apply(ctx, plan)
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+)
+
+// Reconciler plans and applies the changes converging virtual machines to
+// their specs.
+type Reconciler struct {
+	client *onappgo.Client
+
+	// WaitOptions of the transactions of the steps
+	WaitOptions *onappgo.TransactionWaitOptions
+}
+
+// New returns a Reconciler changing the virtual machines with the client.
+func New(client *onappgo.Client) *Reconciler {
+	return &Reconciler{client: client}
+}
+
+// StepError reports the step of a plan which failed.
+type StepError struct {
+	// VirtualMachineID is zero if the virtual machine wasn't created
+	VirtualMachineID int
+	Step             Step
+	Err              error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("reconcile: %s: %v", e.Step, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// Apply makes the changes of the plan in order, waiting for the transactions
+// of each step before the next one, and returns the ID of the virtual
+// machine. It stops at the first failure with a StepError, the next plan
+// resumes from there.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) (int, error) {
+	a := &applier{Reconciler: r, spec: plan.spec, vmID: plan.VirtualMachineID}
+
+	for _, step := range plan.Steps {
+		if err := a.do(ctx, step); err != nil {
+			return a.vmID, &StepError{VirtualMachineID: a.vmID, Step: step, Err: err}
+		}
+	}
+
+	return a.vmID, nil
+}
+
+// applier applies the steps of a plan.
+type applier struct {
+	*Reconciler
+
+	spec *Spec
+	vmID int
+}
+
+// do applies the step and waits for the transactions it made.
+func (a *applier) do(ctx context.Context, step Step) error {
+	var last int
+	if a.vmID != 0 {
+		var err error
+		if last, _, err = a.client.Transactions.LastID(ctx, a.transactions()); err != nil {
+			return err
+		}
+	}
+
+	if err := step.apply(ctx, a); err != nil {
+		return err
+	}
+
+	if a.vmID == 0 {
+		return nil
+	}
+
+	_, err := a.client.Transactions.WaitAfter(ctx, a.transactions(), last, a.WaitOptions)
+	return err
+}
+
+// transactions returns the options listing the transactions of the virtual
+// machine.
+func (a *applier) transactions() *onappgo.TransactionListOptions {
+	return &onappgo.TransactionListOptions{
+		AssociatedObjectID:   a.vmID,
+		AssociatedObjectType: "VirtualMachine",
+	}
+}
+
+// interfaceID returns the ID of the network interface of the spec.
+func (a *applier) interfaceID(ctx context.Context, i int) (int, error) {
+	interfaces, _, err := a.client.VirtualMachines.ListNetworkInterfaces(ctx, a.vmID, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	nic := matchInterface(a.spec, i, interfaces)
+	if nic == nil {
+		return 0, fmt.Errorf("network interface %q not found", a.spec.NetworkInterfaces[i].Label)
+	}
+
+	return nic.ID, nil
+}
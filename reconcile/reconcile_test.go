@@ -0,0 +1,127 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	onappgo "github.com/OnApp/onapp-sdk-go"
+	"github.com/OnApp/onapp-sdk-go/onappgotest"
+)
+
+func kinds(plan *Plan) []StepKind {
+	var kinds []StepKind
+	for _, step := range plan.Steps {
+		kinds = append(kinds, step.Kind)
+	}
+	return kinds
+}
+
+func TestReconciler(t *testing.T) {
+	ctx := context.Background()
+	srv := onappgotest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	network, _, err := client.Networks.Create(ctx, &onappgo.NetworkCreateRequest{Label: "public"})
+	require.NoError(t, err)
+	ipNet, _, err := client.IPNets.Create(ctx, network.ID, &onappgo.IPNetCreateRequest{
+		Label:             "public v4",
+		NetworkAddress:    "192.0.2.0",
+		NetworkMask:       24,
+		DefaultGateway:    "192.0.2.1",
+		AddDefaultIPRange: 1,
+	})
+	require.NoError(t, err)
+
+	spec, err := ParseSpec([]byte(fmt.Sprintf(`
+label: web
+hostname: web
+template_id: 3
+cpus: 1
+memory: 1024
+disks:
+  - label: root
+    size: 10
+  - label: data
+    size: 20
+    mount_point: /data
+network_interfaces:
+  - label: eth0
+    network_id: %d
+    ip_addresses:
+      - address: 192.0.2.10
+      - ip_net_id: %d
+firewall_rules:
+  - command: ACCEPT
+    protocol: TCP
+    port: "22"
+recipes:
+  - recipe_id: 5
+    event_type: provisioning
+`, network.ID, ipNet.ID)))
+	require.NoError(t, err)
+
+	r := New(client)
+	r.WaitOptions = &onappgo.TransactionWaitOptions{PollInterval: time.Millisecond}
+
+	plan, err := r.Plan(ctx, 0, spec)
+	require.NoError(t, err)
+	require.Equal(t, []StepKind{CreateVirtualMachine, AddDisk, AssignIPAddress, AddFirewallRule, JoinRecipe}, kinds(plan))
+
+	id, err := r.Apply(ctx, plan)
+	require.NoError(t, err)
+
+	vm := srv.VirtualMachine(id)
+	require.True(t, vm.Booted)
+	require.Len(t, vm.IPAddresses, 2)
+	require.Equal(t, "192.0.2.10", vm.IPAddresses[0].IPAddress.Address)
+
+	plan, err = r.Plan(ctx, id, spec)
+	require.NoError(t, err)
+	require.True(t, plan.Empty(), plan.String())
+
+	spec.Cpus = 2
+	spec.Disks[1].Size = 30
+	plan, err = r.Plan(ctx, id, spec)
+	require.NoError(t, err)
+	require.Equal(t, "1. resize virtual machine: CPUs 1 -> 2\n2. resize disk: disk \"data\" 20 GB -> 30 GB\n", plan.String())
+
+	_, err = r.Apply(ctx, plan)
+	require.NoError(t, err)
+	require.Equal(t, 2, srv.VirtualMachine(id).Cpus)
+
+	disks, _, err := client.VirtualMachines.Disks(ctx, id, nil)
+	require.NoError(t, err)
+	require.Equal(t, 30, disks[len(disks)-1].DiskSize)
+
+	spec.Disks[0].Size = 5
+	_, err = r.Plan(ctx, id, spec)
+	require.EqualError(t, err, "reconcile: primary disk can't shrink from 10 GB to 5 GB")
+}
+
+func TestParseSpec_duplicateLabels(t *testing.T) {
+	_, err := ParseSpec([]byte(`
+disks:
+  - label: root
+    size: 10
+  - label: data
+    size: 20
+  - label: data
+    size: 30
+network_interfaces:
+  - label: eth0
+  - label: eth0
+    network_join_id: 2
+`))
+
+	var specErr *SpecError
+	require.ErrorAs(t, err, &specErr)
+	require.Equal(t, []string{
+		`disks[2]: label "data" is used twice`,
+		`network_interfaces[1]: label "eth0" is used twice`,
+	}, specErr.Problems)
+}
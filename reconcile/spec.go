@@ -0,0 +1,261 @@
+package reconcile
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec describes a virtual machine. The zero fields are not managed: the
+// reconciler leaves the current value as it is.
+type Spec struct {
+	Label    string `yaml:"label"`
+	Hostname string `yaml:"hostname"`
+
+	// TemplateID is used to create the virtual machine only, changing it
+	// would need a rebuild
+	TemplateID int `yaml:"template_id"`
+
+	Cpus      int `yaml:"cpus"`
+	CPUShares int `yaml:"cpu_shares"`
+
+	// Memory in MB
+	Memory int `yaml:"memory"`
+
+	Disks             []DiskSpec             `yaml:"disks"`
+	NetworkInterfaces []NetworkInterfaceSpec `yaml:"network_interfaces"`
+	FirewallRules     []FirewallRuleSpec     `yaml:"firewall_rules"`
+	Recipes           []RecipeSpec           `yaml:"recipes"`
+}
+
+// DiskSpec describes a disk. The primary disk, the first one which isn't a
+// swap disk unless another one is marked primary, and the swap disk match
+// the ones of the virtual machine, the others match by label.
+type DiskSpec struct {
+	Label string `yaml:"label"`
+
+	// Size in GB, disks are grown but never shrunk
+	Size int `yaml:"size"`
+
+	Primary bool `yaml:"primary"`
+	Swap    bool `yaml:"swap"`
+
+	DataStoreID int    `yaml:"data_store_id"`
+	FileSystem  string `yaml:"file_system"`
+	MountPoint  string `yaml:"mount_point"`
+}
+
+// NetworkInterfaceSpec describes a network interface. The primary interface,
+// the first one unless another one is marked primary, matches the one of
+// the virtual machine, the others match by label.
+type NetworkInterfaceSpec struct {
+	Label   string `yaml:"label"`
+	Primary bool   `yaml:"primary"`
+
+	// NetworkID of the primary interface, used to create the virtual machine
+	NetworkID int `yaml:"network_id"`
+
+	// NetworkJoinID of the other interfaces, used to add them
+	NetworkJoinID int `yaml:"network_join_id"`
+	RateLimit     int `yaml:"rate_limit"`
+
+	IPAddresses []IPAddressSpec `yaml:"ip_addresses"`
+}
+
+// IPAddressSpec describes an IP address of a network interface. Without
+// Address any free address of the IP net or range is assigned, and any
+// address of the virtual machine not claimed by another spec satisfies it.
+type IPAddressSpec struct {
+	Address   string `yaml:"address"`
+	IPNetID   int    `yaml:"ip_net_id"`
+	IPRangeID int    `yaml:"ip_range_id"`
+}
+
+// FirewallRuleSpec describes a firewall rule of a network interface.
+type FirewallRuleSpec struct {
+	// Interface is the label of the network interface, the primary one when
+	// empty
+	Interface string `yaml:"interface"`
+
+	// Command is ACCEPT or DROP
+	Command  string `yaml:"command"`
+	Protocol string `yaml:"protocol"`
+	Address  string `yaml:"address"`
+	Port     string `yaml:"port"`
+	Comment  string `yaml:"comment"`
+}
+
+// RecipeSpec describes a recipe run on an event of the virtual machine.
+type RecipeSpec struct {
+	RecipeID  int    `yaml:"recipe_id"`
+	EventType string `yaml:"event_type"`
+}
+
+// LoadSpec reads the spec of the YAML file.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := ParseSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return spec, nil
+}
+
+// ParseSpec decodes and validates a YAML spec.
+func ParseSpec(data []byte) (*Spec, error) {
+	spec := new(Spec)
+	if err := yaml.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// Validate reports the problems of the spec.
+func (s *Spec) Validate() error {
+	var problems []string
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	var primaryDisks, swapDisks int
+	diskLabels := map[string]bool{}
+	for i, d := range s.Disks {
+		if d.Size < 1 {
+			add("disks[%d]: size must be at least 1 GB", i)
+		}
+		if d.Primary && d.Swap {
+			add("disks[%d]: a swap disk can't be primary", i)
+		}
+		if d.Primary {
+			primaryDisks++
+		}
+		if d.Swap {
+			swapDisks++
+		}
+		if !d.Primary && !d.Swap && d.Label == "" && i != s.primaryDisk() {
+			add("disks[%d]: label is required", i)
+		}
+		if d.Label != "" && diskLabels[d.Label] {
+			add("disks[%d]: label %q is used twice", i, d.Label)
+		}
+		diskLabels[d.Label] = true
+	}
+	if primaryDisks > 1 {
+		add("disks: only one disk can be primary")
+	}
+	if swapDisks > 1 {
+		add("disks: only one disk can be a swap disk")
+	}
+
+	labels := map[string]bool{}
+	var primaryInterfaces int
+	for i, nic := range s.NetworkInterfaces {
+		if nic.Label == "" {
+			add("network_interfaces[%d]: label is required", i)
+		}
+		if labels[nic.Label] {
+			add("network_interfaces[%d]: label %q is used twice", i, nic.Label)
+		}
+		labels[nic.Label] = true
+
+		if nic.Primary {
+			primaryInterfaces++
+		}
+		if i != s.primaryInterface() && nic.NetworkJoinID == 0 {
+			add("network_interfaces[%d]: network_join_id is required", i)
+		}
+	}
+	if primaryInterfaces > 1 {
+		add("network_interfaces: only one interface can be primary")
+	}
+
+	for i, rule := range s.FirewallRules {
+		if rule.Command != "ACCEPT" && rule.Command != "DROP" {
+			add("firewall_rules[%d]: command must be ACCEPT or DROP", i)
+		}
+		if rule.Interface != "" && !labels[rule.Interface] {
+			add("firewall_rules[%d]: unknown interface %q", i, rule.Interface)
+		}
+		if rule.Interface == "" && len(s.NetworkInterfaces) == 0 {
+			add("firewall_rules[%d]: interface is required without network_interfaces", i)
+		}
+	}
+
+	for i, recipe := range s.Recipes {
+		if recipe.RecipeID < 1 {
+			add("recipes[%d]: recipe_id is required", i)
+		}
+		if recipe.EventType == "" {
+			add("recipes[%d]: event_type is required", i)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &SpecError{Problems: problems}
+	}
+
+	return nil
+}
+
+// SpecError lists the problems of an invalid spec.
+type SpecError struct {
+	Problems []string
+}
+
+func (e *SpecError) Error() string {
+	return "reconcile: invalid spec: " + strings.Join(e.Problems, "; ")
+}
+
+// primaryDisk returns the index of the primary disk, -1 if there is none.
+func (s *Spec) primaryDisk() int {
+	first := -1
+	for i, d := range s.Disks {
+		if d.Primary {
+			return i
+		}
+		if first < 0 && !d.Swap {
+			first = i
+		}
+	}
+
+	return first
+}
+
+// swapDisk returns the index of the swap disk, -1 if there is none.
+func (s *Spec) swapDisk() int {
+	for i, d := range s.Disks {
+		if d.Swap {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// primaryInterface returns the index of the primary network interface, -1 if
+// there is none.
+func (s *Spec) primaryInterface() int {
+	for i, nic := range s.NetworkInterfaces {
+		if nic.Primary {
+			return i
+		}
+	}
+
+	if len(s.NetworkInterfaces) > 0 {
+		return 0
+	}
+
+	return -1
+}
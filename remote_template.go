@@ -2,7 +2,6 @@ package onappgo
 
 import (
 	"context"
-	"log"
 	"net/http"
 )
 
@@ -65,7 +64,6 @@ func (s *RemoteTemplatesServiceOp) List(ctx context.Context, opt *ListOptions) (
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("RemoteTemplate [List] req: ", req)
 
 	var out []map[string]RemoteTemplate
 	resp, err := s.client.Do(ctx, req, &out)
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -121,7 +120,6 @@ func (s *ResolversServiceOp) Create(ctx context.Context, createRequest *Resolver
 	if err != nil {
 		return nil, nil, err
 	}
-	fmt.Println("Resolver [Create] req: ", req)
 
 	root := new(resolverRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -148,7 +146,6 @@ func (s *ResolversServiceOp) Delete(ctx context.Context, id int, meta interface{
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("Resolver [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -169,7 +166,6 @@ func (s *ResolversServiceOp) Edit(ctx context.Context, id int, editRequest *Reso
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Resolver [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -0,0 +1,220 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures the retries of the requests which failed with a
+// transient error: a connection error or one of StatusCodes.
+type RetryPolicy struct {
+	// Maximum number of retries of a request. Zero disables retries.
+	MaxRetries int
+
+	// Delay before the first retry, doubled for every next one with a random
+	// jitter. Defaults to 500 milliseconds.
+	MinBackoff time.Duration
+
+	// Upper bound for the delay between retries. Defaults to 30 seconds. The
+	// delay asked by the Retry-After header is honored as is.
+	MaxBackoff time.Duration
+
+	// HTTP methods which are retried. Defaults to the idempotent methods,
+	// add http.MethodPost to retry the actions as well.
+	Methods []string
+
+	// Response status codes which are retried. Defaults to 429, 502, 503
+	// and 504.
+	StatusCodes []int
+}
+
+var (
+	defaultRetryMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodDelete,
+	}
+
+	defaultRetryStatusCodes = []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+)
+
+// SetRetryPolicy is a client option for retrying the requests which failed
+// with a transient error.
+func SetRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		p = p.withDefaults()
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+// RetryMiddleware returns a middleware retrying the requests which failed
+// with a transient error, like SetRetryPolicy but at any place of the chain
+// of middlewares.
+func RetryMiddleware(p RetryPolicy) Middleware {
+	p = p.withDefaults()
+	return p.middleware
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MinBackoff <= 0 {
+		p.MinBackoff = defaultRetryMinBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaultRetryMaxBackoff
+	}
+	if p.MaxBackoff < p.MinBackoff {
+		p.MaxBackoff = p.MinBackoff
+	}
+	if p.Methods == nil {
+		p.Methods = defaultRetryMethods
+	}
+	if p.StatusCodes == nil {
+		p.StatusCodes = defaultRetryStatusCodes
+	}
+
+	return p
+}
+
+type retryKey struct{}
+
+// WithRetry returns a copy of ctx which allows retrying the requests created
+// with it whatever their HTTP method is, for example a POST action known to
+// be safe to repeat.
+func WithRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryKey{}, true)
+}
+
+func (p *RetryPolicy) retryMethod(ctx context.Context, method string) bool {
+	if force, _ := ctx.Value(retryKey{}).(bool); force {
+		return true
+	}
+
+	return StringInSlice(p.Methods, method, true)
+}
+
+func (p *RetryPolicy) retryResponse(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	for _, code := range p.StatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the retry number attempt+1.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	d := p.MinBackoff << uint(attempt)
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	// equal jitter: keep half of the delay and randomize the other half
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// retryAfter parses the value of the Retry-After header, given either in
+// seconds or as an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// retryMiddleware retries the requests as configured by the retry policy of
+// the client.
+func (c *Client) retryMiddleware(next RoundTripFunc) RoundTripFunc {
+	if c.retryPolicy == nil {
+		return next
+	}
+
+	return c.retryPolicy.middleware(next)
+}
+
+func (p *RetryPolicy) middleware(next RoundTripFunc) RoundTripFunc {
+	if p.MaxRetries < 1 {
+		return next
+	}
+
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		if !p.retryMethod(ctx, req.Method) {
+			return next(ctx, req)
+		}
+
+		for attempt := 0; ; attempt++ {
+			if attempt > 0 && req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req.Body = body
+			}
+
+			resp, err := next(ctx, req)
+			if attempt >= p.MaxRetries || !p.retryResponse(ctx, resp, err) {
+				return resp, err
+			}
+
+			delay := p.backoff(attempt, resp)
+			if resp != nil {
+				_, _ = io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package onappgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Millisecond,
+	MaxBackoff: 2 * time.Millisecond,
+}
+
+func TestDo_retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	require.NoError(t, SetRetryPolicy(testRetryPolicy)(client))
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	body := make(map[string]string)
+	_, err := client.Do(ctx, req, &body)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+	require.Equal(t, "a", body["A"])
+}
+
+func TestDo_retryPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	require.NoError(t, SetRetryPolicy(testRetryPolicy)(client))
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var v map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+			t.Errorf("Request body error: %v", err)
+		}
+		if v["B"] != "b" {
+			t.Errorf("Request body = %v, expected %v", v, map[string]string{"B": "b"})
+		}
+
+		calls++
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	// POST is not retried by default
+	req, _ := client.NewRequest(ctx, http.MethodPost, "/", map[string]string{"B": "b"})
+	_, err := client.Do(ctx, req, nil)
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+
+	// the body is replayed when the retry is allowed
+	calls = 0
+	req, _ = client.NewRequest(ctx, http.MethodPost, "/", map[string]string{"B": "b"})
+	_, err = client.Do(WithRetry(ctx), req, nil)
+	require.Error(t, err)
+	require.Equal(t, 4, calls)
+}
+
+func TestRetryAfter(t *testing.T) {
+	d, ok := retryAfter("7")
+	require.True(t, ok)
+	require.Equal(t, 7*time.Second, d)
+
+	d, ok = retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+	require.True(t, d > 59*time.Minute)
+
+	_, ok = retryAfter("soon")
+	require.False(t, ok)
+}
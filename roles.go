@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -137,7 +136,6 @@ func (s *RolesServiceOp) Create(ctx context.Context, createRequest *RoleCreateRe
 	if err != nil {
 		return nil, nil, err
 	}
-	fmt.Println("Role [Create] req: ", req)
 
 	root := new(roleRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -164,7 +162,6 @@ func (s *RolesServiceOp) Delete(ctx context.Context, id int, meta interface{}) (
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("Role [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -189,7 +186,6 @@ func (s *RolesServiceOp) Edit(ctx context.Context, id int, editRequest *RoleCrea
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Role [Edit] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -135,7 +134,6 @@ func (s *SoftwareLicensesServiceOp) Create(ctx context.Context, createRequest *S
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("SoftwareLicense [Create]  req: ", req)
 
 	root := new(softwareLicenseRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -163,7 +161,6 @@ func (s *SoftwareLicensesServiceOp) Delete(ctx context.Context, id int) (*Respon
 	if err != nil {
 		return nil, err
 	}
-	log.Println("SoftwareLicense [Delete]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -180,7 +177,6 @@ func (s *SoftwareLicensesServiceOp) Edit(ctx context.Context, id int, editReques
 	if err != nil {
 		return nil, err
 	}
-	log.Println("SoftwareLicense [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
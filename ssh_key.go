@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -129,7 +128,6 @@ func (s *SSHKeysServiceOp) Create(ctx context.Context, createRequest *SSHKeyCrea
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("SSHKey [Create]  req: ", req)
 
 	root := new(sshKeyRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -157,7 +155,6 @@ func (s *SSHKeysServiceOp) Delete(ctx context.Context, id int, meta interface{})
 	if err != nil {
 		return nil, err
 	}
-	log.Println("SSHKey [Delete]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -174,7 +171,6 @@ func (s *SSHKeysServiceOp) Edit(ctx context.Context, id int, editRequest *SSHKey
 	if err != nil {
 		return nil, err
 	}
-	log.Println("SSHKey [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -221,7 +220,6 @@ func (s *HypervisorsServiceOp) Create(ctx context.Context, createRequest *Hyperv
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("Hypervisor [Create] req: ", req)
 
 	root := new(hypervisorRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -248,7 +246,6 @@ func (s *HypervisorsServiceOp) Delete(ctx context.Context, id int, meta interfac
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Hypervisor [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -265,7 +262,6 @@ func (s *HypervisorsServiceOp) Edit(ctx context.Context, id int, editRequest *Hy
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Hypervisor [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -289,7 +285,6 @@ func (s *HypervisorsServiceOp) Reboot(ctx context.Context, id int, rebootRequest
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Hypervisor [Reboot] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -374,7 +369,6 @@ func (s *HypervisorsServiceOp) Refresh(ctx context.Context, resID int) (*Hardwar
 	key, _ := uuid.NewRandom()
 	req.Header.Add("X-Idempotency-Key", key.String())
 
-	log.Println("Hypervisor [Refresh] req: ", req)
 
 	out := &rootHardware{}
 	resp, err := s.client.Do(ctx, req, out)
@@ -440,7 +434,6 @@ func (s *HypervisorsServiceOp) Attach(ctx context.Context, resID int, attachRequ
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Hypervisor [Attach] req: ", req)
 
 	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
@@ -487,7 +480,6 @@ func (s *HypervisorsServiceOp) GetIntegratedStorageSettings(ctx context.Context,
 	if err != nil {
 		return nil, resp, err
 	}
-	log.Println("Hypervisor [GetIntegratedStorageSettings]  req: ", req)
 
 	return root.IntegratedStorageSettings, resp, err
 }
@@ -508,7 +500,6 @@ func (s *HypervisorsServiceOp) EditIntegratedStorageSettings(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Hypervisor [EditIntegratedStorageSettings]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
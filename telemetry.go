@@ -0,0 +1,235 @@
+package onappgo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/OnApp/onapp-sdk-go/version"
+)
+
+const instrumentationName = "github.com/OnApp/onapp-sdk-go"
+
+// Attributes of the spans and metrics recorded by the client
+const (
+	attrService       = attribute.Key("onapp.service")
+	attrMethod        = attribute.Key("onapp.method")
+	attrResourceIDs   = attribute.Key("onapp.resource.ids")
+	attrRequestID     = attribute.Key("onapp.request_id")
+	attrTransactionID = attribute.Key("onapp.transaction.id")
+	attrHTTPMethod    = attribute.Key("http.request.method")
+	attrHTTPStatus    = attribute.Key("http.response.status_code")
+	attrURLPath       = attribute.Key("url.path")
+)
+
+type telemetry struct {
+	tracer  trace.Tracer
+	metrics *requestMetrics
+}
+
+type requestMetrics struct {
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// SetTracerProvider is a client option for tracing every request with
+// OpenTelemetry. The spans are named after the service and the method, for
+// example "VirtualMachines.Get". Transaction waits are traced as well.
+func SetTracerProvider(tp trace.TracerProvider) ClientOpt {
+	return func(c *Client) error {
+		c.telemetry.tracer = tp.Tracer(instrumentationName, trace.WithInstrumentationVersion(sdk.String()))
+		return nil
+	}
+}
+
+// SetMeterProvider is a client option for recording the duration of the
+// requests and the number of failed ones with OpenTelemetry.
+func SetMeterProvider(mp metric.MeterProvider) ClientOpt {
+	return func(c *Client) error {
+		m, err := newRequestMetrics(mp)
+		if err != nil {
+			return err
+		}
+
+		c.telemetry.metrics = m
+		return nil
+	}
+}
+
+// MetricsMiddleware returns a middleware recording the metrics of the
+// requests like SetMeterProvider, but at any place of the chain of
+// middlewares.
+func MetricsMiddleware(mp metric.MeterProvider) (Middleware, error) {
+	m, err := newRequestMetrics(mp)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.middleware, nil
+}
+
+func newRequestMetrics(mp metric.MeterProvider) (*requestMetrics, error) {
+	meter := mp.Meter(instrumentationName, metric.WithInstrumentationVersion(sdk.String()))
+
+	duration, err := meter.Float64Histogram("onapp.client.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of the requests made to the OnApp API"))
+	if err != nil {
+		return nil, err
+	}
+
+	errors, err := meter.Int64Counter("onapp.client.request.errors",
+		metric.WithDescription("Number of the requests made to the OnApp API which failed"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestMetrics{duration: duration, errors: errors}, nil
+}
+
+// metricsMiddleware records the metrics of the requests with the meter
+// provider of the client.
+func (c *Client) metricsMiddleware(next RoundTripFunc) RoundTripFunc {
+	if c.telemetry.metrics == nil {
+		return next
+	}
+
+	return c.telemetry.metrics.middleware(next)
+}
+
+func (m *requestMetrics) middleware(next RoundTripFunc) RoundTripFunc {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		startedAt := time.Now()
+		resp, err := next(ctx, req)
+		duration := time.Since(startedAt)
+
+		op := newAPIOperation(req)
+		attrs := []attribute.KeyValue{
+			attrService.String(op.Service),
+			attrMethod.String(op.Method),
+		}
+		if resp != nil {
+			attrs = append(attrs, attrHTTPStatus.Int(resp.StatusCode))
+		}
+
+		m.duration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+		if err != nil || resp.StatusCode >= http.StatusBadRequest {
+			m.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+
+		return resp, err
+	}
+}
+
+// startSpan starts a span when tracing is enabled, otherwise the returned
+// span does nothing.
+func (c *Client) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if c.telemetry.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	return c.telemetry.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// operationSpan is the span of the request of a service method triggering a
+// transaction, see traceOperation.
+type operationSpan struct {
+	span trace.Span
+}
+
+type operationSpanKey struct{}
+
+// keptSpan is a span ended by its operation instead of Client.Do.
+type keptSpan struct {
+	trace.Span
+}
+
+func (keptSpan) End(...trace.SpanEndOption) {}
+
+// traceOperation keeps the span of the next request made with the returned
+// context open until end is called, so the ID of the transaction found by
+// requestTransaction is added to it. The requests made after it with the
+// context, to find the transaction, are traced as its children.
+func (c *Client) traceOperation(ctx context.Context) (context.Context, func()) {
+	if c.telemetry.tracer == nil {
+		return ctx, func() {}
+	}
+
+	op := new(operationSpan)
+	return context.WithValue(ctx, operationSpanKey{}, op), func() {
+		if op.span != nil {
+			op.span.End()
+		}
+	}
+}
+
+// startRequestSpan starts the span of a request made by Client.Do.
+func (c *Client) startRequestSpan(ctx context.Context, req *http.Request) (context.Context, trace.Span) {
+	if c.telemetry.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	op, ok := ctx.Value(operationSpanKey{}).(*operationSpan)
+	if ok && op.span != nil {
+		ctx = trace.ContextWithSpan(ctx, op.span)
+	}
+
+	ctx, span := c.startAPISpan(ctx, req)
+	if ok && op.span == nil {
+		op.span = span
+		return ctx, keptSpan{span}
+	}
+
+	return ctx, span
+}
+
+func (c *Client) startAPISpan(ctx context.Context, req *http.Request) (context.Context, trace.Span) {
+	op := newAPIOperation(req)
+	return c.telemetry.tracer.Start(ctx, op.Name(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrService.String(op.Service),
+			attrMethod.String(op.Method),
+			attrResourceIDs.IntSlice(op.IDs),
+			attrHTTPMethod.String(req.Method),
+			attrURLPath.String(req.URL.Path),
+			attrRequestID.String(req.Header.Get(headerRequestID)),
+		),
+	)
+}
+
+// endRequestSpan records the result of a request made by Client.Do, the span
+// is ended by the caller.
+func endRequestSpan(span trace.Span, resp *http.Response, err error) {
+	if resp != nil {
+		span.SetAttributes(attrHTTPStatus.Int(resp.StatusCode))
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, strconv.Itoa(resp.StatusCode))
+	}
+}
+
+// traceTransaction adds the ID of the transaction to the span of the
+// operation of ctx, see traceOperation, or else to the span of ctx.
+func traceTransaction(ctx context.Context, trx *Transaction) {
+	if trx == nil {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if op, ok := ctx.Value(operationSpanKey{}).(*operationSpan); ok && op.span != nil {
+		span = op.span
+	}
+
+	span.SetAttributes(attrTransactionID.Int(trx.ID))
+}
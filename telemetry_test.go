@@ -0,0 +1,87 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestDo_tracing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	require.NoError(t, SetTracerProvider(tp)(client))
+
+	mux.HandleFunc("/transactions/3.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"transaction":{"id":3,"status":"complete"}}`)
+	})
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.Transactions.Wait(ctx, 3, testWaitOptions)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	wait := spans[2]
+	require.Equal(t, "Transactions.Wait", wait.Name())
+
+	get := spans[0]
+	require.Equal(t, "Transactions.Get", get.Name())
+	require.Equal(t, wait.SpanContext().SpanID(), get.Parent().SpanID())
+
+	attrs := make(map[string]interface{})
+	for _, kv := range get.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	require.Equal(t, []int64{3}, attrs["onapp.resource.ids"])
+	require.Equal(t, int64(3), attrs["onapp.transaction.id"])
+	require.Equal(t, int64(http.StatusOK), attrs["http.response.status_code"])
+
+	require.Equal(t, "Transactions.List", spans[1].Name())
+}
+
+func TestDo_tracingActionTransaction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	require.NoError(t, SetTracerProvider(tp)(client))
+
+	mux.HandleFunc("/virtual_machines/1/stop.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+	})
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"transaction":{"id":11,"actor":"%s","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"stop_virtual_machine"}}]`, email)
+	})
+
+	trx, _, err := client.VirtualMachineActions.Stop(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 11, trx.ID)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	lookup := spans[0]
+	require.Equal(t, "Transactions.List", lookup.Name())
+
+	stop := spans[1]
+	require.Equal(t, "VirtualMachines.Stop", stop.Name())
+	require.Equal(t, stop.SpanContext().SpanID(), lookup.Parent().SpanID())
+
+	attrs := make(map[string]interface{})
+	for _, kv := range stop.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	require.Equal(t, int64(11), attrs["onapp.transaction.id"])
+	require.Equal(t, int64(http.StatusOK), attrs["http.response.status_code"])
+}
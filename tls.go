@@ -0,0 +1,167 @@
+package onappgo
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+// SetClientCertificate is a client option for authenticating to the Control
+// Panel, or to the mutual TLS gateway in front of it, with a client
+// certificate.
+func SetClientCertificate(cert tls.Certificate) ClientOpt {
+	return func(c *Client) error {
+		cfg, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+		c.useTransport()
+		return nil
+	}
+}
+
+// SetClientCertificateFile is a client option for loading the client
+// certificate from a pair of PEM encoded certificate and key files.
+func SetClientCertificateFile(certFile, keyFile string) ClientOpt {
+	return func(c *Client) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("onapp client certificate: %w", err)
+		}
+
+		return SetClientCertificate(cert)(c)
+	}
+}
+
+// SetRootCAs is a client option for verifying the certificate of the server
+// with the given certificate authorities, instead of the ones of the system.
+func SetRootCAs(pool *x509.CertPool) ClientOpt {
+	return func(c *Client) error {
+		cfg, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		cfg.RootCAs = pool
+		c.useTransport()
+		return nil
+	}
+}
+
+// SetCABundle is a client option for verifying the certificate of the server
+// with the certificate authorities of a PEM encoded bundle file, for example
+// a private CA.
+func SetCABundle(path string) ClientOpt {
+	return func(c *Client) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("onapp CA bundle: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("onapp CA bundle: no certificate found in %s", path)
+		}
+
+		return SetRootCAs(pool)(c)
+	}
+}
+
+// SetPinnedCertificates is a client option for accepting only the servers
+// whose certificate chain contains a public key with one of the given pins.
+// A pin is the base64 encoded SHA-256 digest of the DER encoded public key
+// (SubjectPublicKeyInfo), optionally prefixed by "sha256/", as printed by:
+//
+//	openssl x509 -in cert.pem -pubkey -noout | openssl pkey -pubin -outform der | openssl dgst -sha256 -binary | base64
+//
+// The pins are checked against the verified chains in addition to the usual
+// verification, or against the certificate of the server only instead of it
+// with SetAllowUnverifiedSSL(true).
+func SetPinnedCertificates(pins ...string) ClientOpt {
+	return func(c *Client) error {
+		if len(pins) == 0 {
+			return godo.NewArgError("pins", "cannot be empty")
+		}
+
+		digests := make(map[[sha256.Size]byte]bool, len(pins))
+		for _, pin := range pins {
+			data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(pin, "sha256/"))
+			if err != nil || len(data) != sha256.Size {
+				return godo.NewArgError("pins", fmt.Sprintf("invalid SHA-256 pin %q", pin))
+			}
+
+			var digest [sha256.Size]byte
+			copy(digest[:], data)
+			digests[digest] = true
+		}
+
+		cfg, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			// the peer certificates are sent by the server as they are, only
+			// the verified chains, or the leaf without verification, can be
+			// trusted
+			for _, chain := range cs.VerifiedChains {
+				for _, cert := range chain {
+					if digests[sha256.Sum256(cert.RawSubjectPublicKeyInfo)] {
+						return nil
+					}
+				}
+			}
+
+			if len(cs.VerifiedChains) == 0 && len(cs.PeerCertificates) > 0 {
+				if digests[sha256.Sum256(cs.PeerCertificates[0].RawSubjectPublicKeyInfo)] {
+					return nil
+				}
+			}
+
+			return errors.New("onapp: certificate of the server does not match the pinned certificates")
+		}
+		c.useTransport()
+		return nil
+	}
+}
+
+// errTLSTransport is returned by the TLS options when the transport of the
+// HTTP client is not an *http.Transport, it is never replaced since it may
+// instrument or proxy the requests.
+var errTLSTransport = errors.New("onapp: the TLS options need an HTTP client with an *http.Transport, configure the TLS of its transport instead")
+
+// tlsConfig returns the TLS configuration of the transport of the client,
+// created if needed.
+func (c *Client) tlsConfig() (*tls.Config, error) {
+	if c.transport == nil {
+		return nil, errTLSTransport
+	}
+
+	if c.transport.TLSClientConfig == nil {
+		c.transport.TLSClientConfig = &tls.Config{}
+	}
+
+	return c.transport.TLSClientConfig, nil
+}
+
+// useTransport makes the client send the requests with its own transport,
+// wrapped by the wrappers of WrapTransport. The HTTP client is copied so the
+// one given to NewClient, http.DefaultClient by default, is not modified.
+func (c *Client) useTransport() {
+	var rt http.RoundTripper = c.transport
+	for _, wrap := range c.wrappers {
+		rt = wrap(rt)
+	}
+
+	hc := *c.client
+	hc.Transport = rt
+	c.client = &hc
+	c.ownTransport = true
+}
@@ -0,0 +1,177 @@
+package onappgo
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newClientCertificate returns a self-signed client certificate.
+func newClientCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "onappgo"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestClient_mutualTLS(t *testing.T) {
+	cert := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert.Leaf)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "6.5"}`)
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	defer srv.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, os.WriteFile(bundle, data, 0o600))
+
+	digest := sha256.Sum256(srv.Certificate().RawSubjectPublicKeyInfo)
+	pin := "sha256/" + base64.StdEncoding.EncodeToString(digest[:])
+
+	get := func(opts ...ClientOpt) error {
+		c, err := New(nil, append(opts, SetBaseURL(srv.URL))...)
+		require.NoError(t, err)
+
+		req, err := c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+		require.NoError(t, err)
+
+		_, err = c.Do(ctx, req, nil)
+		return err
+	}
+
+	require.NoError(t, get(SetCABundle(bundle), SetClientCertificate(cert), SetPinnedCertificates(pin)))
+	require.NoError(t, get(SetClientCertificate(cert), SetAllowUnverifiedSSL(true)))
+
+	// unknown authority
+	require.Error(t, get(SetClientCertificate(cert)))
+
+	// no client certificate
+	require.Error(t, get(SetCABundle(bundle)))
+
+	// pin of another key
+	other := "sha256/" + base64.StdEncoding.EncodeToString(make([]byte, sha256.Size))
+	require.Error(t, get(SetAllowUnverifiedSSL(true), SetClientCertificate(cert), SetPinnedCertificates(other)))
+
+	require.Nil(t, http.DefaultClient.Transport)
+}
+
+// newServerCertificate returns a self-signed certificate of 127.0.0.1.
+func newServerCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestSetPinnedCertificates_appendedCertificate(t *testing.T) {
+	pinned := newServerCertificate(t)
+	digest := sha256.Sum256(pinned.Leaf.RawSubjectPublicKeyInfo)
+	pin := "sha256/" + base64.StdEncoding.EncodeToString(digest[:])
+
+	// the server presents its own certificate followed by the pinned one
+	cert := newServerCertificate(t)
+	cert.Certificate = append(cert.Certificate, pinned.Certificate[0])
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "6.5"}`)
+	}))
+	srv.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	srv.StartTLS()
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert.Leaf)
+
+	get := func(opts ...ClientOpt) error {
+		c, err := New(nil, append(opts, SetBaseURL(srv.URL))...)
+		require.NoError(t, err)
+
+		req, err := c.NewRequest(ctx, http.MethodGet, "version.json", nil)
+		require.NoError(t, err)
+
+		_, err = c.Do(ctx, req, nil)
+		return err
+	}
+
+	require.NoError(t, get(SetRootCAs(roots)))
+	require.Error(t, get(SetRootCAs(roots), SetPinnedCertificates(pin)))
+	require.Error(t, get(SetAllowUnverifiedSSL(true), SetPinnedCertificates(pin)))
+}
+
+// failingTransport is a transport which is not an *http.Transport.
+type failingTransport struct{}
+
+func (*failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("not sent")
+}
+
+func TestTLSOptions_customTransport(t *testing.T) {
+	rt := new(failingTransport)
+	hc := &http.Client{Transport: rt}
+
+	for _, opt := range []ClientOpt{
+		SetRootCAs(x509.NewCertPool()),
+		SetClientCertificate(tls.Certificate{}),
+		SetPinnedCertificates(base64.StdEncoding.EncodeToString(make([]byte, sha256.Size))),
+		SetAllowUnverifiedSSL(true),
+	} {
+		_, err := New(hc, opt)
+		require.ErrorIs(t, err, errTLSTransport)
+	}
+
+	// the transport is kept by the other options
+	c, err := New(hc, SetUserAgent("test"))
+	require.NoError(t, err)
+	require.Same(t, rt, c.client.Transport)
+}
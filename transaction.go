@@ -2,9 +2,11 @@ package onappgo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"reflect"
+	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 )
@@ -34,8 +36,13 @@ type TransactionsService interface {
 	List(context.Context, *ListOptions) ([]Transaction, *Response, error)
 	Get(context.Context, int) (*Transaction, *Response, error)
 
-	GetByFilter(context.Context, interface{}, *ListOptions) (*Transaction, *Response, error)
-	ListByGroup(context.Context, interface{}, bool, *ListOptions) ([]Transaction, *Response, error)
+	Filter(context.Context, *TransactionListOptions) ([]Transaction, *Response, error)
+	GetByFilter(context.Context, *TransactionListOptions) (*Transaction, *Response, error)
+	ListByGroup(context.Context, *TransactionListOptions, bool) ([]Transaction, *Response, error)
+
+	Wait(context.Context, int, *TransactionWaitOptions) (*Transaction, *Response, error)
+	LastID(context.Context, *TransactionListOptions) (int, *Response, error)
+	WaitAfter(context.Context, *TransactionListOptions, int, *TransactionWaitOptions) (*Response, error)
 }
 
 // TransactionsServiceOp handles communition with the image action related methods of the
@@ -72,6 +79,26 @@ type Transaction struct {
 	Params                 map[string]interface{} `json:"params,omitempty"`
 }
 
+// TransactionListOptions specifies the filters of the TransactionsService
+// lookups. Zero values are not sent to the OnApp API.
+type TransactionListOptions struct {
+	ListOptions
+
+	AssociatedObjectID   int    `url:"associated_object_id,omitempty"`
+	AssociatedObjectType string `url:"associated_object_type,omitempty"`
+	ParentID             int    `url:"parent_id,omitempty"`
+	ParentType           string `url:"parent_type,omitempty"`
+	Status               string `url:"status,omitempty"`
+	Action               string `url:"action,omitempty"`
+
+	// Only transactions created at or after this time
+	Since time.Time `url:"since,omitempty"`
+}
+
+// ErrTransactionNotFound is returned with the response of a request which
+// succeeded when the transaction it triggered is not found.
+var ErrTransactionNotFound = errors.New("onapp: transaction not found")
+
 type transactionRoot struct {
 	Transaction *Transaction `json:"transaction"`
 }
@@ -124,167 +151,200 @@ func (s *TransactionsServiceOp) Get(ctx context.Context, id int) (*Transaction,
 	return root.Transaction, resp, err
 }
 
-// ListByGroup return group of transactions depended by action
-func (s *TransactionsServiceOp) ListByGroup(ctx context.Context, meta interface{}, revers bool, opt *ListOptions) ([]Transaction, *Response, error) {
-	var associatedObjectID, parentID int
-	var associatedObjectType, parentType string
-
-	val := reflect.ValueOf(meta)
-
-	v1 := val.FieldByName("AssociatedObjectID")
-	if v1.IsValid() {
-		associatedObjectID = v1.Interface().(int)
-		// fmt.Printf("associatedObjectID: <%d>\n", associatedObjectID)
-
-		if associatedObjectID < 1 {
-			return nil, nil, godo.NewArgError("associatedObjectID", "cannot be less than 1")
-		}
+// Filter lists transactions which match the options. Filtering is done by the
+// OnApp API, the result is verified on the client side as well.
+func (s *TransactionsServiceOp) Filter(ctx context.Context, opt *TransactionListOptions) ([]Transaction, *Response, error) {
+	path := transactionsBasePath + apiFormat
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	v2 := val.FieldByName("ParentID")
-	if v2.IsValid() {
-		parentID = v2.Interface().(int)
-		// fmt.Printf("parentID: <%d>\n", parentID)
-
-		if parentID < 1 {
-			return nil, nil, godo.NewArgError("parentID", "cannot be less than 1")
-		}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	v1 = val.FieldByName("AssociatedObjectType")
-	if v1.IsValid() {
-		associatedObjectType = v1.String()
+	var out []map[string]Transaction
+	resp, err := s.client.Do(ctx, req, &out)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	v2 = val.FieldByName("ParentType")
-	if v2.IsValid() {
-		parentType = v2.String()
+	trx := make([]Transaction, 0, len(out))
+	for i := range out {
+		cur := out[i]["transaction"]
+		if cur.matches(opt) {
+			trx = append(trx, cur)
+		}
 	}
 
-	lst, resp, err := s.client.Transactions.List(ctx, opt)
+	return trx, resp, err
+}
+
+// ListByGroup return group of transactions from the chain of the latest
+// transaction which match the options. The group is ordered from the newest to
+// the oldest transaction, or in reverse order if revers is true.
+func (s *TransactionsServiceOp) ListByGroup(ctx context.Context, opt *TransactionListOptions, revers bool) ([]Transaction, *Response, error) {
+	lst, resp, err := s.Filter(ctx, opt)
 	if err != nil {
-		return nil, resp, fmt.Errorf("ListByGroup.lst: %s", err)
+		return nil, resp, fmt.Errorf("ListByGroup.lst: %w", err)
 	}
 
-	var next *Transaction
-
-	len := len(lst)
-	var groupList []Transaction
+	if len(lst) == 0 {
+		return nil, resp, err
+	}
 
-	for i, cur := range lst {
-		if associatedObjectType != "" {
-			// fmt.Printf("cur.AssociatedObjectID: <%d> -> associatedObjectID: <%d>\n", cur.AssociatedObjectID, associatedObjectID)
-			// fmt.Printf("cur.AssociatedObjectType: <%s> -> associatedObjectType: <%s>\n", cur.AssociatedObjectType, associatedObjectType)
-			if cur.AssociatedObjectID != associatedObjectID || cur.AssociatedObjectType != associatedObjectType {
-				continue
-			}
-		}
+	head := lst[0]
+	groupList := []Transaction{head}
 
-		if parentType != "" {
-			// fmt.Printf("cur.ParentID: <%d> -> parentID: <%d>\n", cur.ParentID, parentID)
-			// fmt.Printf("cur.ParentType: <%s> -> parentType: <%s>\n", cur.ParentType, parentType)
-			if cur.ParentID != parentID || cur.ParentType != parentType {
+	if head.ChainID != 0 && head.DependentTransactionID != 0 {
+		for _, cur := range lst[1:] {
+			if cur.ChainID != head.ChainID {
 				continue
 			}
-		}
 
-		if cur.DependentTransactionID == 0 {
-			if revers == false {
-				groupList = append(groupList, cur)
-			} else {
-				groupList = append([]Transaction{cur}, groupList...) // prepend
+			groupList = append(groupList, cur)
+			if cur.DependentTransactionID == 0 {
+				break
 			}
-			break
-		}
-
-		if i+1 < len {
-			next = &lst[i+1]
 		}
+	}
 
-		if next != nil {
-			if associatedObjectType != "" {
-				if cur.AssociatedObjectID == next.AssociatedObjectID &&
-					cur.AssociatedObjectType == next.AssociatedObjectType &&
-					cur.ChainID == next.ChainID {
-					if revers == false {
-						groupList = append(groupList, cur)
-					} else {
-						groupList = append([]Transaction{cur}, groupList...) // prepend
-					}
-				}
-			}
-
-			if parentType != "" {
-				if cur.ParentID == next.ParentID &&
-					cur.ParentType == next.ParentType &&
-					cur.ChainID == next.ChainID {
-					if revers == false {
-						groupList = append(groupList, cur)
-					} else {
-						groupList = append([]Transaction{cur}, groupList...) // prepend
-					}
-				}
-			}
+	if revers {
+		for i, j := 0, len(groupList)-1; i < j; i, j = i+1, j-1 {
+			groupList[i], groupList[j] = groupList[j], groupList[i]
 		}
 	}
 
 	return groupList, resp, err
 }
 
-// GetByFilter find transaction with specified fields.
-func (s *TransactionsServiceOp) GetByFilter(ctx context.Context, filter interface{}, opts *ListOptions) (*Transaction, *Response, error) {
-	lst, resp, err := s.client.Transactions.List(ctx, opts)
+// GetByFilter find the latest transaction which match the options.
+func (s *TransactionsServiceOp) GetByFilter(ctx context.Context, opt *TransactionListOptions) (*Transaction, *Response, error) {
+	lst, resp, err := s.Filter(ctx, opt)
 	if err != nil {
-		return nil, resp, fmt.Errorf("GetByFilter.lst: %s", err)
+		return nil, resp, fmt.Errorf("GetByFilter.lst: %w", err)
 	}
 
-	for _, v := range lst {
-		if v.equal(filter) {
-			return &v, resp, err
-		}
+	if len(lst) == 0 {
+		return nil, resp, fmt.Errorf("Transaction not found or wrong filter %+v", opt)
 	}
 
-	return nil, nil, fmt.Errorf("Transaction not found or wrong filter %+v", filter)
+	return &lst[0], resp, err
 }
 
-// EqualFilter -
-func (trx *Transaction) EqualFilter(filter interface{}) bool {
-	return trx.equal(filter)
+// EqualFilter check if transaction match the options.
+func (trx *Transaction) EqualFilter(opt *TransactionListOptions) bool {
+	return trx.matches(opt)
 }
 
-func (trx *Transaction) equal(filter interface{}) bool {
-	val := reflect.ValueOf(filter)
-	filterFields := reflect.Indirect(reflect.ValueOf(trx))
+func (trx *Transaction) matches(opt *TransactionListOptions) bool {
+	if opt == nil {
+		return true
+	}
+
+	if opt.AssociatedObjectType != "" && trx.AssociatedObjectType != opt.AssociatedObjectType {
+		return false
+	}
+
+	if opt.AssociatedObjectID != 0 && trx.AssociatedObjectID != opt.AssociatedObjectID {
+		return false
+	}
 
-	// fmt.Printf("        equal.filterFields: %#v\n", filterFields)
-	for i := 0; i < val.NumField(); i++ {
-		typeField := val.Type().Field(i)
-		value := val.Field(i)
-		filterValue := filterFields.FieldByName(typeField.Name)
+	if opt.ParentType != "" && trx.ParentType != opt.ParentType {
+		return false
+	}
 
-		// fmt.Printf("%s: %s[%#v] -> %s[%#v]\n", typeField.Name, value.Type(), value, filterValue.Type(), filterValue)
+	if opt.ParentID != 0 && trx.ParentID != opt.ParentID {
+		return false
+	}
+
+	if opt.Status != "" && trx.Status != opt.Status {
+		return false
+	}
 
-		if value.Interface() != filterValue.Interface() {
-			// fmt.Printf("[false] return on filed [%s]\n\n", typeField.Name)
+	if opt.Action != "" && trx.Action != opt.Action {
+		return false
+	}
+
+	if !opt.Since.IsZero() {
+		createdAt, err := trx.CreatedTime()
+		if err == nil && createdAt.Before(opt.Since) {
 			return false
 		}
 	}
 
-	// fmt.Printf("[true] transaction found with id[%d]\n\n", trx.ID)
 	return true
 }
 
-func lastTransaction(ctx context.Context, client *Client, filter interface{}) (*Transaction, *Response, error) {
-	opt := &ListOptions{
-		PerPage: searchTransactions,
+// requestTransaction returns the transaction triggered by the request of resp:
+// the oldest transaction which matches the options, was created after the
+// request started and was made by the user of the request. OnApp doesn't
+// record the X-Request-Id on the transactions, so the transactions of
+// concurrent requests are told apart by their actor. When the request has no
+// Basic Auth user, for example with a bearer token, the actor is unknown and
+// the transaction is matched by the options and its creation time only.
+// ErrTransactionNotFound is returned when no transaction matches, the
+// returned response is resp itself. No transaction is returned for the
+// requests of the dry-run mode.
+func requestTransaction(ctx context.Context, client *Client, resp *Response, opt *TransactionListOptions) (*Transaction, *Response, error) {
+	if resp.DryRun {
+		return nil, resp, nil
 	}
 
-	lst, resp, err := client.Transactions.ListByGroup(ctx, filter, false, opt)
-	if lst == nil || err != nil {
-		return nil, nil, err
+	filter := TransactionListOptions{}
+	if opt != nil {
+		filter = *opt
+	}
+	if filter.PerPage == 0 {
+		filter.PerPage = searchTransactions
 	}
+	filter.Since = resp.serverStartedAt()
 
-	return &lst[0], resp, err
+	lst, _, err := client.Transactions.Filter(ctx, &filter)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// transactions are listed from the newest to the oldest one
+	for i := len(lst) - 1; i >= 0; i-- {
+		if resp.triggered(&lst[i]) {
+			traceTransaction(ctx, &lst[i])
+			return &lst[i], resp, nil
+		}
+	}
+
+	return nil, resp, ErrTransactionNotFound
+}
+
+// user returns the Basic Auth user of the request of the response, empty if
+// there is none.
+func (r *Response) user() string {
+	if r.Response == nil || r.Request == nil {
+		return ""
+	}
+
+	user, _, _ := r.Request.BasicAuth()
+	return user
+}
+
+// triggered reports whether the transaction may have been triggered by the
+// request of the response: it was made by the user of the request, or by
+// anyone when the request has no Basic Auth user.
+func (r *Response) triggered(trx *Transaction) bool {
+	user := r.user()
+	return user == "" || trx.madeBy(user)
+}
+
+// madeBy reports whether the transaction was made by the user.
+func (trx *Transaction) madeBy(user string) bool {
+	return user != "" && strings.EqualFold(trx.Actor, user)
+}
+
+// CreatedTime parses CreatedAt of the transaction.
+func (trx Transaction) CreatedTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, trx.CreatedAt)
 }
 
 func (trx Transaction) String() string {
@@ -0,0 +1,100 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactions_Filter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{
+			"per_page":               "10",
+			"associated_object_id":   "5",
+			"associated_object_type": "VirtualMachine",
+			"action":                 "stop_virtual_machine",
+			"since":                  testTimeString,
+		})
+
+		// the second transaction must be dropped by the client side check
+		fmt.Fprint(w, `[
+			{"transaction":{"id":2,"associated_object_id":5,"associated_object_type":"VirtualMachine","action":"stop_virtual_machine","created_at":"2020-04-20T00:00:01Z"}},
+			{"transaction":{"id":1,"associated_object_id":6,"associated_object_type":"VirtualMachine","action":"stop_virtual_machine","created_at":"2020-04-20T00:00:00Z"}}
+		]`)
+	})
+
+	opt := &TransactionListOptions{
+		ListOptions:          ListOptions{PerPage: 10},
+		AssociatedObjectID:   5,
+		AssociatedObjectType: "VirtualMachine",
+		Action:               "stop_virtual_machine",
+		Since:                testTime,
+	}
+
+	got, _, err := client.Transactions.Filter(ctx, opt)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, 2, got[0].ID)
+}
+
+func TestTransaction_EqualFilter(t *testing.T) {
+	trx := &Transaction{
+		Action:     "destroy_disk",
+		ParentID:   3,
+		ParentType: "Disk",
+		CreatedAt:  testTimeString,
+	}
+
+	require.True(t, trx.EqualFilter(&TransactionListOptions{ParentID: 3, ParentType: "Disk"}))
+	require.False(t, trx.EqualFilter(&TransactionListOptions{ParentID: 4, ParentType: "Disk"}))
+	require.False(t, trx.EqualFilter(&TransactionListOptions{Since: testTime.Add(time.Second)}))
+}
+
+func TestRequestTransaction_otherActor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/virtual_machines/1/stop.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+	})
+
+	// another operator stopped the virtual machine at the same time
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"transaction":{"id":10,"actor":"other@example.com","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"stop_virtual_machine"}}
+		]`)
+	})
+
+	req, err := client.NewRequest(ctx, http.MethodPost, "virtual_machines/1/stop.json", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	opt := &TransactionListOptions{
+		AssociatedObjectID:   1,
+		AssociatedObjectType: "VirtualMachine",
+		Action:               "stop_virtual_machine",
+	}
+	trx, _, err := requestTransaction(ctx, client, resp, opt)
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+	require.Nil(t, trx)
+
+	// the options of the caller are left as they are
+	require.Zero(t, opt.PerPage)
+	require.True(t, opt.Since.IsZero())
+
+	// without a Basic Auth user the actor is unknown, the transaction is
+	// matched by the options
+	req.Header.Set("Authorization", "Bearer token")
+	trx, _, err = requestTransaction(ctx, client, &Response{Response: &http.Response{Request: req}}, opt)
+	require.NoError(t, err)
+	require.Equal(t, 10, trx.ID)
+}
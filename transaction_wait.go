@@ -0,0 +1,262 @@
+package onappgo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	defaultWaitPollInterval    = 2 * time.Second
+	defaultWaitMaxPollInterval = 30 * time.Second
+	defaultWaitBackoff         = 1.5
+)
+
+// TransactionWaitOptions specifies the optional parameters to the
+// TransactionsService.Wait method.
+type TransactionWaitOptions struct {
+	// Delay before the second poll of every transaction. Defaults to 2 seconds.
+	PollInterval time.Duration
+
+	// Upper bound for the delay between polls. Defaults to 30 seconds.
+	MaxPollInterval time.Duration
+
+	// Factor applied to the delay after every poll. Defaults to 1.5,
+	// use 1 to poll with a constant interval.
+	Backoff float64
+
+	// Maximum time to wait for the whole chain. Zero means the wait is only
+	// bounded by the context.
+	Timeout time.Duration
+
+	// Optional function called with every polled transaction
+	Progress func(*Transaction)
+}
+
+// TransactionError reports a transaction which finished as failed or cancelled.
+type TransactionError struct {
+	// Transaction that caused this error
+	Transaction *Transaction
+}
+
+func (e *TransactionError) Error() string {
+	trx := e.Transaction
+	return fmt.Sprintf("transaction %d [%s] for %s %d is %s",
+		trx.ID, trx.Action, trx.AssociatedObjectType, trx.AssociatedObjectID, trx.Status)
+}
+
+func (opts *TransactionWaitOptions) withDefaults() TransactionWaitOptions {
+	var o TransactionWaitOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultWaitPollInterval
+	}
+	if o.MaxPollInterval <= 0 {
+		o.MaxPollInterval = defaultWaitMaxPollInterval
+	}
+	if o.MaxPollInterval < o.PollInterval {
+		o.MaxPollInterval = o.PollInterval
+	}
+	if o.Backoff <= 0 {
+		o.Backoff = defaultWaitBackoff
+	}
+
+	return o
+}
+
+// Wait blocks until the transaction and every transaction chained to it are
+// finished. The last finished transaction of the chain is returned. If any of
+// them failed or was cancelled the error is a *TransactionError.
+func (s *TransactionsServiceOp) Wait(ctx context.Context, id int, opts *TransactionWaitOptions) (*Transaction, *Response, error) {
+	if id < 1 {
+		return nil, nil, godo.NewArgError("id", "cannot be less than 1")
+	}
+
+	ctx, span := s.client.startSpan(ctx, "Transactions.Wait", attrTransactionID.Int(id))
+	defer span.End()
+
+	o := opts.withDefaults()
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+
+	trx, resp, err := s.waitOne(ctx, id, &o)
+	if err != nil {
+		return trx, resp, err
+	}
+
+	// the chain is created together with its first transaction
+	since, _ := trx.CreatedTime()
+
+	done := map[int]bool{trx.ID: true}
+	for {
+		next, lresp, err := s.nextInChain(ctx, trx, since, done)
+		if err != nil {
+			return trx, lresp, err
+		}
+
+		if next == nil {
+			return trx, resp, nil
+		}
+
+		if next.Finished() {
+			if o.Progress != nil {
+				o.Progress(next)
+			}
+			if next.Unlucky() {
+				return next, lresp, &TransactionError{Transaction: next}
+			}
+			trx, resp = next, lresp
+		} else {
+			trx, resp, err = s.waitOne(ctx, next.ID, &o)
+			if err != nil {
+				return trx, resp, err
+			}
+		}
+
+		done[trx.ID] = true
+	}
+}
+
+// LastID returns the ID of the newest transaction which matches the options,
+// zero if there are none. The transactions made afterwards by a change are
+// the ones WaitAfter waits for.
+func (s *TransactionsServiceOp) LastID(ctx context.Context, opt *TransactionListOptions) (int, *Response, error) {
+	filter := TransactionListOptions{}
+	if opt != nil {
+		filter = *opt
+	}
+	filter.PerPage = 1
+
+	lst, resp, err := s.Filter(ctx, &filter)
+	if err != nil || len(lst) == 0 {
+		return 0, resp, err
+	}
+
+	return lst[0].ID, resp, nil
+}
+
+// WaitAfter waits for the transactions which match the options and are newer
+// than the after one, whoever made them, from the oldest to the newest one.
+// See Wait for the errors.
+func (s *TransactionsServiceOp) WaitAfter(ctx context.Context, opt *TransactionListOptions, after int, opts *TransactionWaitOptions) (*Response, error) {
+	filter := TransactionListOptions{}
+	if opt != nil {
+		filter = *opt
+	}
+	if filter.PerPage == 0 {
+		filter.PerPage = searchTransactions
+	}
+
+	lst, resp, err := s.Filter(ctx, &filter)
+	if err != nil {
+		return resp, err
+	}
+
+	// transactions are listed from the newest to the oldest one
+	for i := len(lst) - 1; i >= 0; i-- {
+		if lst[i].ID <= after || lst[i].Finished() {
+			continue
+		}
+
+		if _, resp, err = s.Wait(ctx, lst[i].ID, opts); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// waitOne polls a single transaction until it is finished.
+func (s *TransactionsServiceOp) waitOne(ctx context.Context, id int, o *TransactionWaitOptions) (*Transaction, *Response, error) {
+	interval := o.PollInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("waiting for transaction %d: %w", id, ctx.Err())
+		case <-timer.C:
+		}
+
+		trx, resp, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if o.Progress != nil {
+			o.Progress(trx)
+		}
+
+		if trx.Finished() {
+			if trx.Unlucky() {
+				return trx, resp, &TransactionError{Transaction: trx}
+			}
+			return trx, resp, nil
+		}
+
+		timer.Reset(interval)
+		interval = time.Duration(float64(interval) * o.Backoff)
+		if interval > o.MaxPollInterval {
+			interval = o.MaxPollInterval
+		}
+	}
+}
+
+// nextInChain returns the oldest not yet handled transaction which depends on
+// one of the done transactions or shares the chain with trx. The transactions
+// of a chain may be associated with several objects, so every page of the
+// transactions created since the chain started is searched.
+func (s *TransactionsServiceOp) nextInChain(ctx context.Context, trx *Transaction, since time.Time, done map[int]bool) (*Transaction, *Response, error) {
+	opt := &TransactionListOptions{
+		ListOptions: ListOptions{PerPage: searchTransactions},
+		Since:       since,
+	}
+
+	var next *Transaction
+	var resp *Response
+	for page := 1; ; page++ {
+		opt.Page = page
+
+		lst, lresp, err := s.Filter(ctx, opt)
+		if err != nil {
+			return nil, lresp, fmt.Errorf("nextInChain.lst: %w", err)
+		}
+		resp = lresp
+
+		// transactions are listed from the newest to the oldest one, the
+		// next pages hold older ones
+		for i := range lst {
+			cur := lst[i]
+			if done[cur.ID] {
+				continue
+			}
+
+			if done[cur.DependentTransactionID] || (trx.ChainID != 0 && cur.ChainID == trx.ChainID) {
+				next = &cur
+			}
+		}
+
+		if !morePages(resp, page, len(lst)) {
+			return next, resp, nil
+		}
+	}
+}
+
+// morePages reports whether there are pages after the page of the response,
+// the short page being the last one when OnApp doesn't report the pagination.
+func morePages(resp *Response, page, n int) bool {
+	if resp != nil && resp.Links != nil && resp.Links.NumPages > 0 {
+		return page < resp.Links.NumPages
+	}
+
+	return n >= searchTransactions
+}
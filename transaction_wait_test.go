@@ -0,0 +1,156 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testWaitOptions = &TransactionWaitOptions{
+	PollInterval:    time.Millisecond,
+	MaxPollInterval: 5 * time.Millisecond,
+}
+
+func TestTransactions_Wait(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/transactions/1.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		polls++
+		status := TransactionRunning
+		if polls > 2 {
+			status = TransactionComplete
+		}
+		fmt.Fprintf(w, `{"transaction":{"id":1,"chain_id":7,"action":"build_disk","status":"%s"}}`, status)
+	})
+
+	mux.HandleFunc("/transactions/2.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"transaction":{"id":2,"chain_id":7,"dependent_transaction_id":1,"action":"startup_virtual_machine","status":"complete"}}`)
+	})
+
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"transaction":{"id":3,"chain_id":8,"action":"stop_virtual_machine","status":"running"}},
+			{"transaction":{"id":2,"chain_id":7,"dependent_transaction_id":1,"action":"startup_virtual_machine","status":"running"}},
+			{"transaction":{"id":1,"chain_id":7,"action":"build_disk","status":"complete"}}
+		]`)
+	})
+
+	var progress []int
+	opts := *testWaitOptions
+	opts.Progress = func(trx *Transaction) {
+		progress = append(progress, trx.ID)
+	}
+
+	got, _, err := client.Transactions.Wait(ctx, 1, &opts)
+	require.NoError(t, err)
+	require.Equal(t, 2, got.ID)
+	require.True(t, got.Complete())
+	require.Equal(t, []int{1, 1, 1, 2}, progress)
+}
+
+func TestTransactions_Wait_pages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactions/1.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"transaction":{"id":1,"chain_id":7,"action":"build_disk","status":"complete"}}`)
+	})
+
+	// the next transaction of the chain is on the second page
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerPerPage, "2")
+		w.Header().Set(headerTotal, "4")
+		w.Header().Set(headerPage, r.FormValue("page"))
+		switch r.FormValue("page") {
+		case "1":
+			fmt.Fprint(w, `[
+				{"transaction":{"id":4,"chain_id":8,"action":"stop_virtual_machine","status":"running"}},
+				{"transaction":{"id":3,"chain_id":9,"action":"stop_virtual_machine","status":"running"}}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"transaction":{"id":2,"chain_id":7,"dependent_transaction_id":1,"action":"startup_virtual_machine","status":"complete"}},
+				{"transaction":{"id":1,"chain_id":7,"action":"build_disk","status":"complete"}}
+			]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	got, resp, err := client.Transactions.Wait(ctx, 1, testWaitOptions)
+	require.NoError(t, err)
+	require.Equal(t, 2, got.ID)
+
+	// the response is the one of the listing which found the transaction
+	require.Equal(t, "/transactions.json", resp.Request.URL.Path)
+}
+
+func TestTransactions_Wait_failed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactions/1.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"transaction":{"id":1,"action":"destroy_disk","status":"failed"}}`)
+	})
+
+	_, _, err := client.Transactions.Wait(ctx, 1, testWaitOptions)
+
+	var trxErr *TransactionError
+	require.True(t, errors.As(err, &trxErr))
+	require.Equal(t, 1, trxErr.Transaction.ID)
+	require.True(t, trxErr.Transaction.Unlucky())
+}
+
+func TestTransactions_Wait_timeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactions/1.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"transaction":{"id":1,"status":"pending"}}`)
+	})
+
+	opts := *testWaitOptions
+	opts.Timeout = 20 * time.Millisecond
+
+	_, _, err := client.Transactions.Wait(ctx, 1, &opts)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestTransactions_WaitAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var queries []string
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `[
+			{"transaction":{"id":3,"associated_object_id":1,"associated_object_type":"VirtualMachine","status":"pending"}},
+			{"transaction":{"id":2,"associated_object_id":1,"associated_object_type":"VirtualMachine","status":"complete"}},
+			{"transaction":{"id":1,"associated_object_id":1,"associated_object_type":"VirtualMachine","status":"pending"}}
+		]`)
+	})
+
+	// only the unfinished transactions newer than the first one are waited for
+	var polled []int
+	mux.HandleFunc("/transactions/3.json", func(w http.ResponseWriter, r *http.Request) {
+		polled = append(polled, 3)
+		fmt.Fprint(w, `{"transaction":{"id":3,"status":"complete"}}`)
+	})
+
+	opt := &TransactionListOptions{AssociatedObjectID: 1, AssociatedObjectType: "VirtualMachine"}
+	_, err := client.Transactions.WaitAfter(ctx, opt, 1, testWaitOptions)
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, polled)
+	require.Equal(t, "associated_object_id=1&associated_object_type=VirtualMachine&per_page=100", queries[0])
+	require.Zero(t, opt.PerPage)
+}
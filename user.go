@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -201,7 +200,6 @@ func (s *UsersServiceOp) Create(ctx context.Context, createRequest *UserCreateRe
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("User [Create]  req: ", req)
 
 	root := new(userRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -239,7 +237,6 @@ func (s *UsersServiceOp) Delete(ctx context.Context, id int, meta interface{}) (
 	if err != nil {
 		return nil, err
 	}
-	log.Println("User [Delete]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -260,7 +257,6 @@ func (s *UsersServiceOp) Edit(ctx context.Context, id int, editRequest *UserEdit
 	if err != nil {
 		return nil, err
 	}
-	log.Println("User [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -277,7 +273,6 @@ func (s *UsersServiceOp) MakeNewAPIKey(ctx context.Context, id int) (string, *Re
 		return "", nil, err
 	}
 
-	log.Println("User [MakeNewAPIKey]  req: ", req)
 
 	var out map[string]interface{}
 	resp, err := s.client.Do(ctx, req, &out)
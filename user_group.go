@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -157,7 +156,6 @@ func (s *UserGroupsServiceOp) Create(ctx context.Context, createRequest *UserGro
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("UserGroup [Create]  req: ", req)
 
 	root := new(userGroupRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -184,7 +182,6 @@ func (s *UserGroupsServiceOp) Delete(ctx context.Context, id int, meta interface
 	if err != nil {
 		return nil, err
 	}
-	log.Println("UserGroup [Delete]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -205,7 +202,6 @@ func (s *UserGroupsServiceOp) Edit(ctx context.Context, id int, editRequest *Use
 	if err != nil {
 		return nil, err
 	}
-	log.Println("UserGroup [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -3,7 +3,6 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/digitalocean/godo"
@@ -125,7 +124,6 @@ func (s *UserWhiteListsServiceOp) Create(ctx context.Context, userID int, create
 	if err != nil {
 		return nil, nil, err
 	}
-	fmt.Println("UserWhiteList [Create] req: ", req)
 
 	root := new(userWhiteListRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -153,7 +151,6 @@ func (s *UserWhiteListsServiceOp) Delete(ctx context.Context, userID int, id int
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("UserWhiteList [Delete] req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -174,7 +171,6 @@ func (s *UserWhiteListsServiceOp) Edit(ctx context.Context, userID int, id int,
 	if err != nil {
 		return nil, err
 	}
-	log.Println("UserWhiteList [Edit]  req: ", req)
 
 	return s.client.Do(ctx, req, nil)
 }
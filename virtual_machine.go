@@ -3,8 +3,8 @@ package onappgo
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/digitalocean/godo"
 )
@@ -19,7 +19,8 @@ type VirtualMachinesService interface {
 	Get(context.Context, int) (*VirtualMachine, *Response, error)
 	Create(context.Context, *VirtualMachineCreateRequest) (*VirtualMachine, *Response, error)
 	Delete(context.Context, int, interface{}) (*Transaction, *Response, error)
-	// Edit(context.Context, int, *ListOptions) ([]VirtualMachine, *Response, error)
+	Edit(context.Context, int, *VirtualMachineEditRequest) (*VirtualMachineEdit, *Response, error)
+	CreateAndWait(context.Context, *VirtualMachineCreateRequest, *VirtualMachineBuildOptions) (*VirtualMachineBuild, *Response, error)
 
 	// TODO !!!
 	// Move next functions to the VirtualMachineActionsService
@@ -179,6 +180,84 @@ func (d VirtualMachineCreateRequest) String() string {
 	return godo.Stringify(d)
 }
 
+// VirtualMachineEditRequest represents a request to edit a VirtualMachine.
+// Changing the CPUs, the CPU shares or the memory resizes it.
+type VirtualMachineEditRequest struct {
+	AdminNote      string `json:"admin_note,omitempty"`
+	CoresPerSocket int    `json:"cores_per_socket,omitempty"`
+	CPUShares      int    `json:"cpu_shares,omitempty"`
+	CPUSockets     string `json:"cpu_sockets,omitempty"`
+	Cpus           int    `json:"cpus,omitempty"`
+	Label          string `json:"label,omitempty"`
+	Memory         int    `json:"memory,omitempty"`
+}
+
+type virtualMachineEditRequestRoot struct {
+	VirtualMachineEditRequest *VirtualMachineEditRequest `json:"virtual_machine"`
+}
+
+func (d VirtualMachineEditRequest) String() string {
+	return godo.Stringify(d)
+}
+
+// VirtualMachineEdit is the result of VirtualMachinesService.Edit.
+type VirtualMachineEdit struct {
+	// Transaction resizing the VirtualMachine, nil if it was not resized
+	Transaction *Transaction
+
+	// RebootRequired is set when the running VirtualMachine is rebooted by
+	// the resize, as told by the action of the transaction, or as predicted
+	// by VirtualMachine.ResizeRequiresReboot when it is not found
+	RebootRequired bool
+
+	// MayHotMigrate is set when OnApp may move the running VirtualMachine to
+	// another compute resource if its own lacks the resources of a resize
+	// without reboot, see VirtualMachine.AllowedHotMigrate
+	MayHotMigrate bool
+}
+
+// Resizes reports whether the request changes the resources of vm.
+func (d *VirtualMachineEditRequest) Resizes(vm *VirtualMachine) bool {
+	return changes(d.Cpus, vm.Cpus) ||
+		changes(d.CPUShares, vm.CPUShares) ||
+		changes(d.CoresPerSocket, vm.CoresPerSocket) ||
+		(d.CPUSockets != "" && d.CPUSockets != vm.CPUSockets) ||
+		changes(d.Memory, vm.Memory)
+}
+
+// ResizeRequiresReboot reports whether resizing the VirtualMachine as
+// requested reboots it. A running VirtualMachine is resized without reboot
+// when only the CPU shares change, or the added CPUs and memory can be hot
+// plugged as allowed by HotAddCPU and HotAddMemory. Removing CPUs or memory
+// and changing the CPU topology always requires a reboot.
+func (vm *VirtualMachine) ResizeRequiresReboot(d *VirtualMachineEditRequest) bool {
+	if !vm.Booted || !d.Resizes(vm) {
+		return false
+	}
+
+	if changes(d.CoresPerSocket, vm.CoresPerSocket) || (d.CPUSockets != "" && d.CPUSockets != vm.CPUSockets) {
+		return true
+	}
+
+	if changes(d.Cpus, vm.Cpus) && (d.Cpus < vm.Cpus || !enabled(vm.HotAddCPU)) {
+		return true
+	}
+
+	return changes(d.Memory, vm.Memory) && (d.Memory < vm.Memory || !enabled(vm.HotAddMemory))
+}
+
+// changes reports whether the requested value is set and differs from the
+// current one.
+func changes(requested, current int) bool {
+	return requested != 0 && requested != current
+}
+
+// enabled parses the flags OnApp returns as strings, like HotAddCPU.
+func enabled(flag string) bool {
+	b, _ := strconv.ParseBool(flag)
+	return b
+}
+
 // List all VirtualMachines.
 func (s *VirtualMachinesServiceOp) List(ctx context.Context, opt *ListOptions) ([]VirtualMachine, *Response, error) {
 	path := virtualMachineBasePath + apiFormat
@@ -243,7 +322,6 @@ func (s *VirtualMachinesServiceOp) Create(ctx context.Context, createRequest *Vi
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("VirtualMachine [Create]  req: ", req)
 
 	root := new(virtualMachineRoot)
 	resp, err := s.client.Do(ctx, req, root)
@@ -254,6 +332,113 @@ func (s *VirtualMachinesServiceOp) Create(ctx context.Context, createRequest *Vi
 	return root.VirtualMachine, resp, err
 }
 
+// Edit changes the label, the admin note or the resources of the
+// VirtualMachine. The VirtualMachine is fetched first to find out how it is
+// resized, the transaction of the resize tells whether it is rebooted. When
+// that transaction is not found the edit is returned with
+// ErrTransactionNotFound.
+func (s *VirtualMachinesServiceOp) Edit(ctx context.Context, id int, editRequest *VirtualMachineEditRequest) (*VirtualMachineEdit, *Response, error) {
+	if id < 1 {
+		return nil, nil, godo.NewArgError("id", "cannot be less than 1")
+	}
+
+	if editRequest == nil {
+		return nil, nil, godo.NewArgError("editRequest", "cannot be nil")
+	}
+
+	vm, resp, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var last int
+	resizes := editRequest.Resizes(vm)
+	if resizes {
+		// the transaction of the resize is newer than the last one
+		if last, resp, err = s.client.Transactions.LastID(ctx, virtualMachineTransactions(id)); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	path := fmt.Sprintf("%s/%d%s", virtualMachineBasePath, id, apiFormat)
+
+	rootRequest := &virtualMachineEditRequestRoot{
+		VirtualMachineEditRequest: editRequest,
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, rootRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the span of the request lasts until its transaction is found
+	ctx, end := s.client.traceOperation(ctx)
+	defer end()
+
+	resp, err = s.client.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	edit := new(VirtualMachineEdit)
+	if !resizes {
+		return edit, resp, nil
+	}
+
+	// OnApp decides whether the VirtualMachine is rebooted, the prediction is
+	// only kept when the transaction is not found
+	edit.RebootRequired = vm.ResizeRequiresReboot(editRequest)
+	edit.Transaction, err = s.resizeTransaction(ctx, id, last, resp)
+	if edit.Transaction != nil {
+		edit.RebootRequired = vm.Booted && edit.Transaction.Action == "resize_vm"
+	}
+	edit.MayHotMigrate = vm.Booted && !edit.RebootRequired && vm.AllowedHotMigrate
+
+	return edit, resp, err
+}
+
+// resizeTransaction returns the oldest resize transaction of the
+// VirtualMachine newer than the after one made by the user of the edit
+// request of resp, with or without reboot. See requestTransaction for the
+// matching of the actor.
+func (s *VirtualMachinesServiceOp) resizeTransaction(ctx context.Context, id int, after int, resp *Response) (*Transaction, error) {
+	if resp.DryRun {
+		return nil, nil
+	}
+
+	opt := &TransactionListOptions{
+		ListOptions:          ListOptions{PerPage: searchTransactions},
+		AssociatedObjectID:   id,
+		AssociatedObjectType: "VirtualMachine",
+		Since:                resp.serverStartedAt(),
+	}
+
+	lst, _, err := s.client.Transactions.Filter(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// transactions are listed from the newest to the oldest one
+	for i := len(lst) - 1; i >= 0; i-- {
+		action := lst[i].Action
+		if lst[i].ID > after && resp.triggered(&lst[i]) && (action == "resize_vm" || action == "resize_vm_without_reboot") {
+			traceTransaction(ctx, &lst[i])
+			return &lst[i], nil
+		}
+	}
+
+	return nil, ErrTransactionNotFound
+}
+
+// virtualMachineTransactions returns the options listing the transactions
+// of the VirtualMachine.
+func virtualMachineTransactions(id int) *TransactionListOptions {
+	return &TransactionListOptions{
+		AssociatedObjectID:   id,
+		AssociatedObjectType: "VirtualMachine",
+	}
+}
+
 // Delete VirtualMachine.
 func (s *VirtualMachinesServiceOp) Delete(ctx context.Context, id int, meta interface{}) (*Transaction, *Response, error) {
 	if id < 1 {
@@ -270,22 +455,23 @@ func (s *VirtualMachinesServiceOp) Delete(ctx context.Context, id int, meta inte
 	if err != nil {
 		return nil, nil, err
 	}
-	log.Println("VirtualMachine [Delete]  req: ", req)
+
+	// the span of the request lasts until its transaction is found
+	ctx, end := s.client.traceOperation(ctx)
+	defer end()
 
 	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	filter := struct {
-		AssociatedObjectID   int
-		AssociatedObjectType string
-	}{
+	opt := &TransactionListOptions{
+		Action:               "destroy_virtual_machine",
 		AssociatedObjectID:   id,
 		AssociatedObjectType: "VirtualMachine",
 	}
 
-	return lastTransaction(ctx, s.client, filter)
+	return requestTransaction(ctx, s.client, resp, opt)
 }
 
 // Backups lists the backups for a VirtualMachine
@@ -21,6 +21,16 @@ type VirtualMachineActionsService interface {
 	Reboot(context.Context, int) (*Transaction, *Response, error)
 	Suspend(context.Context, int) (*Transaction, *Response, error)
 	Unsuspend(context.Context, int) (*Transaction, *Response, error)
+	Rebuild(context.Context, int, *VirtualMachineRebuildRequest) (*Transaction, *Response, error)
+	RebootInRecovery(context.Context, int) (*Transaction, *Response, error)
+	BootFromISO(context.Context, int, *VirtualMachineISOBootRequest) (*Transaction, *Response, error)
+
+	Segregate(context.Context, int, int) (*Transaction, *Response, error)
+	ChangeOwner(context.Context, int, int) (*Transaction, *Response, error)
+	SetVIP(context.Context, int, bool) (*Transaction, *Response, error)
+
+	Migrate(context.Context, int, *VirtualMachineMigrateRequest) (*VirtualMachineMigration, *Response, error)
+	CanHotMigrate(context.Context, int, *VirtualMachineMigrateRequest) ([]string, *Response, error)
 
 	ResetPassword(context.Context, int, string, string) (*Transaction, *Response, error)
 	FQDN(context.Context, int, string, string) (*Transaction, *Response, error)
@@ -58,9 +68,9 @@ func (s *VirtualMachineActionsServiceOp) Startup(ctx context.Context, id int) (*
 	return s.doAction(ctx, id, request, nil, nil)
 }
 
-// Unlock a VirtualMachine
+// Unlock a VirtualMachine, it is done at once so the transaction is nil
 func (s *VirtualMachineActionsServiceOp) Unlock(ctx context.Context, id int) (*Transaction, *Response, error) {
-	request := &ActionRequest{"method": http.MethodPost, "type": "unlock", "action": "startup_virtual_machine"}
+	request := &ActionRequest{"method": http.MethodPost, "type": "unlock"}
 	return s.doAction(ctx, id, request, nil, nil)
 }
 
@@ -87,6 +97,144 @@ func (s *VirtualMachineActionsServiceOp) Unsuspend(ctx context.Context, id int)
 	return s.doAction(ctx, id, request, nil, nil)
 }
 
+// VirtualMachineRebuildRequest represents a request to rebuild a VirtualMachine
+type VirtualMachineRebuildRequest struct {
+	// TemplateID of the new template, zero keeps the current one
+	TemplateID int `json:"template_id,omitempty"`
+
+	// RequiredStartup boots the VirtualMachine once rebuilt
+	RequiredStartup bool `json:"required_startup"`
+}
+
+type virtualMachineRebuildRequestRoot struct {
+	VirtualMachineRebuildRequest *VirtualMachineRebuildRequest `json:"virtual_machine"`
+}
+
+func (d VirtualMachineRebuildRequest) String() string {
+	return godo.Stringify(d)
+}
+
+// Rebuild a VirtualMachine, its disks are formatted and the template is
+// installed again
+func (s *VirtualMachineActionsServiceOp) Rebuild(ctx context.Context, id int, rebuildRequest *VirtualMachineRebuildRequest) (*Transaction, *Response, error) {
+	if rebuildRequest == nil {
+		return nil, nil, godo.NewArgError("rebuildRequest", "cannot be nil")
+	}
+
+	request := &ActionRequest{"method": http.MethodPost, "type": "build", "action": "build_virtual_machine"}
+	root := &virtualMachineRebuildRequestRoot{
+		VirtualMachineRebuildRequest: rebuildRequest,
+	}
+
+	return s.doAction(ctx, id, request, root, nil)
+}
+
+type rebootOptions struct {
+	Mode string `url:"mode,omitempty"`
+}
+
+// RebootInRecovery reboots a VirtualMachine into the recovery mode
+func (s *VirtualMachineActionsServiceOp) RebootInRecovery(ctx context.Context, id int) (*Transaction, *Response, error) {
+	request := &ActionRequest{"method": http.MethodPost, "type": "reboot", "action": "reboot_virtual_machine"}
+	return s.doAction(ctx, id, request, nil, &rebootOptions{Mode: "recovery"})
+}
+
+// VirtualMachineISOBootRequest represents a request to boot a VirtualMachine
+// from an ISO
+type VirtualMachineISOBootRequest struct {
+	IsoID int `json:"iso_id"`
+
+	// CDboot keeps booting the VirtualMachine from the ISO at the next
+	// startups
+	CDboot bool `json:"cdboot,omitempty"`
+}
+
+type virtualMachineISOBootRequestRoot struct {
+	VirtualMachineISOBootRequest *VirtualMachineISOBootRequest `json:"virtual_machine"`
+}
+
+func (d VirtualMachineISOBootRequest) String() string {
+	return godo.Stringify(d)
+}
+
+// BootFromISO starts up a VirtualMachine from an ISO
+func (s *VirtualMachineActionsServiceOp) BootFromISO(ctx context.Context, id int, bootRequest *VirtualMachineISOBootRequest) (*Transaction, *Response, error) {
+	if bootRequest == nil {
+		return nil, nil, godo.NewArgError("bootRequest", "cannot be nil")
+	}
+	if bootRequest.IsoID < 1 {
+		return nil, nil, godo.NewArgError("bootRequest.IsoID", "cannot be less than 1")
+	}
+
+	request := &ActionRequest{"method": http.MethodPost, "type": "startup", "action": "startup_virtual_machine"}
+	root := &virtualMachineISOBootRequestRoot{
+		VirtualMachineISOBootRequest: bootRequest,
+	}
+
+	return s.doAction(ctx, id, request, root, nil)
+}
+
+type segregation struct {
+	StrictVirtualMachineID int `json:"strict_virtual_machine_id"`
+}
+
+type rootSegregation struct {
+	Segregation *segregation `json:"virtual_machine"`
+}
+
+// Segregate a VirtualMachine from another one, they are never placed on the
+// same compute resource. It is done at once so the transaction is nil
+func (s *VirtualMachineActionsServiceOp) Segregate(ctx context.Context, id int, strictVirtualMachineID int) (*Transaction, *Response, error) {
+	if strictVirtualMachineID < 1 {
+		return nil, nil, godo.NewArgError("strictVirtualMachineID", "cannot be less than 1")
+	}
+	if strictVirtualMachineID == id {
+		return nil, nil, godo.NewArgError("strictVirtualMachineID", "cannot be the segregated VirtualMachine")
+	}
+
+	request := &ActionRequest{"method": http.MethodPost, "type": "strict_vm"}
+	root := &rootSegregation{
+		Segregation: &segregation{StrictVirtualMachineID: strictVirtualMachineID},
+	}
+
+	return s.doAction(ctx, id, request, root, nil)
+}
+
+type changeOwner struct {
+	UserID int `json:"user_id"`
+}
+
+// ChangeOwner gives a VirtualMachine to another user, it is done at once so
+// the transaction is nil
+func (s *VirtualMachineActionsServiceOp) ChangeOwner(ctx context.Context, id int, userID int) (*Transaction, *Response, error) {
+	if userID < 1 {
+		return nil, nil, godo.NewArgError("userID", "cannot be less than 1")
+	}
+
+	request := &ActionRequest{"method": http.MethodPost, "type": "change_owner"}
+	return s.doAction(ctx, id, request, &changeOwner{UserID: userID}, nil)
+}
+
+type setVIP struct {
+	Vip bool `json:"vip"`
+}
+
+type rootSetVIP struct {
+	SetVIP *setVIP `json:"virtual_machine"`
+}
+
+// SetVIP sets the VIP status of a VirtualMachine, which is migrated first
+// when its compute resource fails. It is done at once so the transaction is
+// nil
+func (s *VirtualMachineActionsServiceOp) SetVIP(ctx context.Context, id int, vip bool) (*Transaction, *Response, error) {
+	request := &ActionRequest{"method": http.MethodPost, "type": "set_vip"}
+	root := &rootSetVIP{
+		SetVIP: &setVIP{Vip: vip},
+	}
+
+	return s.doAction(ctx, id, request, root, nil)
+}
+
 type resetPassword struct {
 	InitialRootPassword              string `json:"initial_root_password,omitempty"`
 	InitialRootPasswordEncryptionKey string `json:"initial_root_password_encryption_key,omitempty"`
@@ -147,9 +295,14 @@ type rootIPAddress struct {
 	AssignIPAddress *AssignIPAddress `json:"ip_address"`
 }
 
-// AssignIPAddress - Assign IPAddress to the VirtualMachine
+// AssignIPAddress - Assign IPAddress to the VirtualMachine, it is done at once
+// so the transaction is nil
 func (s *VirtualMachineActionsServiceOp) AssignIPAddress(ctx context.Context, id int, params interface{}) (*Transaction, *Response, error) {
-	request := &ActionRequest{"method": http.MethodPost, "type": "assign_ip_address", "action": "ip_addresses"}
+	request := &ActionRequest{
+		"method": http.MethodPost,
+		"type":   "assign_ip_address",
+		"path":   "ip_addresses",
+	}
 
 	// params - must containe required parameters in AssignIPAddress structure
 	return s.doAction(ctx, id, request, params, nil)
@@ -160,12 +313,18 @@ type UnAssignIPAddressRequest struct {
 	RebuildNetwork int `url:"rebuild_network"`
 }
 
-// UnAssignIPAddress - UnAssign IPAddress from the VirtualMachine
+// UnAssignIPAddress - UnAssign IPAddress from the VirtualMachine, it is done
+// at once so the transaction is nil
 func (s *VirtualMachineActionsServiceOp) UnAssignIPAddress(ctx context.Context, id int, ipID int, opts interface{}) (*Transaction, *Response, error) {
-	request := &ActionRequest{"method": http.MethodDelete, "type": "unassign_ip_address", "action": "ip_addresses", "ip_address_id": ipID}
+	request := &ActionRequest{
+		"method":        http.MethodDelete,
+		"type":          "unassign_ip_address",
+		"path":          "ip_addresses",
+		"ip_address_id": ipID,
+	}
 
 	// opts - must containe '?rebuild_network=1' url parameter if needed by UnAssignIPAddressRequest structure
-	return s.doAction(ctx, id, request, nil, nil)
+	return s.doAction(ctx, id, request, nil, opts)
 }
 
 // ListIPAddresses - List IPAddresses from the VirtualMachine
@@ -204,22 +363,27 @@ func (s *VirtualMachineActionsServiceOp) doAction(ctx context.Context, id int,
 		return nil, nil, err
 	}
 
+	// the span of the request lasts until its transaction is found
+	ctx, end := s.client.traceOperation(ctx)
+	defer end()
+
 	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	filter := struct {
-		Action               string
-		AssociatedObjectID   int
-		AssociatedObjectType string
-	}{
+	// the action is done at once, without transaction
+	if (*request)["action"] == nil {
+		return nil, resp, nil
+	}
+
+	opt := &TransactionListOptions{
 		Action:               (*request)["action"].(string),
 		AssociatedObjectID:   id,
 		AssociatedObjectType: "VirtualMachine",
 	}
 
-	return lastTransaction(ctx, s.client, filter)
+	return requestTransaction(ctx, s.client, resp, opt)
 }
 
 func virtualMachineActionPath(id int, request *ActionRequest) (string, error) {
@@ -242,7 +406,6 @@ func virtualMachineActionPath(id int, request *ActionRequest) (string, error) {
 		// url - /virtual_machines/:virtual_machine_id/ip_addresses/:id.json
 		ipAddressID := (*request)["ip_address_id"].(int)
 		res := fmt.Sprintf("%s/%d/%s/%d%s", virtualMachineBasePath, id, path, ipAddressID, apiFormat)
-		fmt.Printf("%s >>> url: %s\n", rtype, res)
 
 		return res, nil
 	}
@@ -0,0 +1,151 @@
+package onappgo
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualMachineActions_Stop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requestID string
+	var query url.Values
+	mux.HandleFunc("/virtual_machines/1/stop.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		requestID = r.Header.Get(headerRequestID)
+	})
+
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		query = r.URL.Query()
+
+		fmt.Fprintf(w, `[
+			{"transaction":{"id":12,"actor":"other@example.com","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"stop_virtual_machine"}},
+			{"transaction":{"id":11,"actor":"%s","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"stop_virtual_machine"}},
+			{"transaction":{"id":10,"actor":"other@example.com","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"stop_virtual_machine"}}
+		]`, email)
+	})
+
+	got, resp, err := client.VirtualMachineActions.Stop(WithRequestID(ctx, "req-1"), 1)
+	require.NoError(t, err)
+	require.Equal(t, 11, got.ID)
+	require.Equal(t, "req-1", requestID)
+	require.Equal(t, "req-1", resp.RequestID)
+	require.Equal(t, "stop_virtual_machine", query.Get("action"))
+	require.NotEmpty(t, query.Get("since"))
+}
+
+func TestVirtualMachineActions_Rebuild(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body []byte
+	var mode string
+	var actions []string
+	mux.HandleFunc("/virtual_machines/1/build.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var err error
+		if body, err = io.ReadAll(r.Body); err != nil {
+			t.Errorf("Request body error: %v", err)
+		}
+	})
+	mux.HandleFunc("/virtual_machines/1/reboot.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		mode = r.URL.Query().Get("mode")
+	})
+	mux.HandleFunc("/virtual_machines/1/unlock.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+	})
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		action := r.URL.Query().Get("action")
+		actions = append(actions, action)
+
+		fmt.Fprintf(w, `[{"transaction":{"id":5,"actor":"%s","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"%s"}}]`, email, action)
+	})
+
+	trx, _, err := client.VirtualMachineActions.Rebuild(ctx, 1, &VirtualMachineRebuildRequest{TemplateID: 7, RequiredStartup: true})
+	require.NoError(t, err)
+	require.Equal(t, "build_virtual_machine", trx.Action)
+	require.JSONEq(t, `{"virtual_machine": {"template_id": 7, "required_startup": true}}`, string(body))
+
+	trx, _, err = client.VirtualMachineActions.RebootInRecovery(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "reboot_virtual_machine", trx.Action)
+	require.Equal(t, "recovery", mode)
+
+	trx, _, err = client.VirtualMachineActions.Unlock(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, trx)
+
+	_, _, err = client.VirtualMachineActions.Segregate(ctx, 1, 1)
+	require.Error(t, err)
+	require.Equal(t, []string{"build_virtual_machine", "reboot_virtual_machine"}, actions)
+}
+
+func TestVirtualMachineActions_payloads(t *testing.T) {
+	setup()
+	defer teardown()
+
+	bodies := make(map[string]string)
+	for _, name := range []string{"change_owner", "set_vip", "startup"} {
+		name := name
+		mux.HandleFunc("/virtual_machines/1/"+name+".json", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("Request body error: %v", err)
+			}
+			bodies[name] = string(body)
+		})
+	}
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("action"); got != "startup_virtual_machine" {
+			t.Errorf("Request action = %v, expected %v", got, "startup_virtual_machine")
+		}
+		fmt.Fprintf(w, `[{"transaction":{"id":5,"actor":"%s","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"startup_virtual_machine"}}]`, email)
+	})
+
+	trx, _, err := client.VirtualMachineActions.ChangeOwner(ctx, 1, 4)
+	require.NoError(t, err)
+	require.Nil(t, trx)
+	require.JSONEq(t, `{"user_id": 4}`, bodies["change_owner"])
+
+	trx, _, err = client.VirtualMachineActions.SetVIP(ctx, 1, true)
+	require.NoError(t, err)
+	require.Nil(t, trx)
+	require.JSONEq(t, `{"virtual_machine": {"vip": true}}`, bodies["set_vip"])
+
+	trx, _, err = client.VirtualMachineActions.BootFromISO(ctx, 1, &VirtualMachineISOBootRequest{IsoID: 3, CDboot: true})
+	require.NoError(t, err)
+	require.Equal(t, 5, trx.ID)
+	require.JSONEq(t, `{"virtual_machine": {"iso_id": 3, "cdboot": true}}`, bodies["startup"])
+
+	_, _, err = client.VirtualMachineActions.ChangeOwner(ctx, 1, 0)
+	require.Error(t, err)
+
+	_, _, err = client.VirtualMachineActions.BootFromISO(ctx, 1, &VirtualMachineISOBootRequest{})
+	require.Error(t, err)
+}
+
+func TestVirtualMachineActions_UnAssignIPAddress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/virtual_machines/1/ip_addresses/7.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testFormValues(t, r, values{"rebuild_network": "1"})
+	})
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("transaction looked up")
+	})
+
+	trx, _, err := client.VirtualMachineActions.UnAssignIPAddress(ctx, 1, 7, &UnAssignIPAddressRequest{RebuildNetwork: 1})
+	require.NoError(t, err)
+	require.Nil(t, trx)
+}
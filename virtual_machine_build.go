@@ -0,0 +1,291 @@
+package onappgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// recipeTransactionActions are the actions of the transactions running a
+// recipe on a VirtualMachine.
+var recipeTransactionActions = map[string]bool{
+	"run_recipe_on_vm": true,
+}
+
+// VirtualMachineBuildOptions specifies the optional parameters to the
+// VirtualMachinesService.CreateAndWait method.
+type VirtualMachineBuildOptions struct {
+	// Wait are the options of the transactions of the build, the VirtualMachine
+	// is polled every PollInterval until they are all queued. Timeout bounds
+	// the whole build.
+	Wait *TransactionWaitOptions
+
+	// Rollback releases the IP addresses of the VirtualMachine and deletes it
+	// when its build fails
+	Rollback bool
+}
+
+// VirtualMachineBuild is a VirtualMachine built by
+// VirtualMachinesService.CreateAndWait
+type VirtualMachineBuild struct {
+	VirtualMachine *VirtualMachine
+
+	// IPAddresses assigned to the VirtualMachine
+	IPAddresses []IPAddress
+
+	// InitialRootPassword of the VirtualMachine, encrypted when the create
+	// request has an encryption key
+	InitialRootPassword string
+
+	// Transactions of the build from the oldest to the newest one
+	Transactions []Transaction
+}
+
+// BuildError reports the failure of the build of a VirtualMachine made by
+// VirtualMachinesService.CreateAndWait
+type BuildError struct {
+	VirtualMachineID int
+
+	// Transaction which failed or was cancelled
+	Transaction *Transaction
+
+	// RecipeFailed is set when the failed transaction runs a recipe
+	RecipeFailed bool
+
+	// RolledBack is set when the IP addresses of the VirtualMachine were
+	// released and the VirtualMachine deleted
+	RolledBack bool
+
+	// RollbackErr is the failure of the rollback, if any
+	RollbackErr error
+
+	Err error
+}
+
+func (e *BuildError) Error() string {
+	what := "build"
+	if e.RecipeFailed {
+		what = "recipe"
+	}
+
+	msg := fmt.Sprintf("onapp: %s of virtual machine %d failed: %v", what, e.VirtualMachineID, e.Err)
+	switch {
+	case e.RolledBack:
+		msg += ", rolled back"
+	case e.RollbackErr != nil:
+		msg += fmt.Sprintf(", rollback failed: %v", e.RollbackErr)
+	}
+
+	return msg
+}
+
+func (e *BuildError) Unwrap() error {
+	return e.Err
+}
+
+// CreateAndWait creates a VirtualMachine and waits until its build,
+// provisioning and recipes are done and it is booted, or built only when the
+// create request doesn't require the startup. The VirtualMachine is returned
+// with a BuildError when a transaction of the build fails, the failed
+// transaction of a recipe has RecipeFailed set. Any other error, such as the
+// end of ctx, leaves the VirtualMachine as it is since it may still be
+// building.
+func (s *VirtualMachinesServiceOp) CreateAndWait(ctx context.Context, createRequest *VirtualMachineCreateRequest, opts *VirtualMachineBuildOptions) (*VirtualMachineBuild, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, godo.NewArgError("createRequest", "cannot be nil")
+	}
+
+	if opts == nil {
+		opts = &VirtualMachineBuildOptions{}
+	}
+
+	vm, resp, err := s.Create(ctx, createRequest)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	build := &VirtualMachineBuild{VirtualMachine: vm}
+	if resp.DryRun {
+		return build, resp, nil
+	}
+
+	built, trxs, err := s.waitBuild(ctx, vm.ID, createRequest.RequiredVirtualMachineStartup, opts.Wait)
+	build.Transactions = trxs
+	if built != nil {
+		build.VirtualMachine = built
+		vm = built
+	}
+
+	if err != nil {
+		var trxErr *TransactionError
+		if !errors.As(err, &trxErr) {
+			// the VirtualMachine may still be building, it is not rolled back
+			return build, resp, err
+		}
+
+		buildErr := &BuildError{
+			VirtualMachineID: vm.ID,
+			Transaction:      trxErr.Transaction,
+			RecipeFailed:     recipeTransactionActions[trxErr.Transaction.Action],
+			Err:              err,
+		}
+
+		if opts.Rollback {
+			buildErr.RollbackErr = s.rollbackBuild(ctx, vm.ID, opts.Wait)
+			buildErr.RolledBack = buildErr.RollbackErr == nil
+		}
+
+		return build, resp, buildErr
+	}
+
+	for _, ip := range vm.IPAddresses {
+		build.IPAddresses = append(build.IPAddresses, ip.IPAddress)
+	}
+
+	build.InitialRootPassword = vm.InitialRootPassword
+	if build.InitialRootPassword == "" {
+		build.InitialRootPassword = createRequest.InitialRootPassword
+	}
+
+	return build, resp, nil
+}
+
+// waitBuild waits until the new VirtualMachine is built, and booted if
+// startup is set, and returns it with its transactions from the oldest to the
+// newest one. The transactions of the build may not all be queued yet when
+// the first ones are finished, so the VirtualMachine is polled until its
+// state is reached. A TransactionError reports the first transaction which
+// failed or was cancelled, any other error leaves the VirtualMachine in an
+// unknown state.
+func (s *VirtualMachinesServiceOp) waitBuild(ctx context.Context, id int, startup bool, opts *TransactionWaitOptions) (*VirtualMachine, []Transaction, error) {
+	o := opts.withDefaults()
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+
+	opt := &TransactionListOptions{
+		ListOptions:          ListOptions{PerPage: searchTransactions},
+		AssociatedObjectID:   id,
+		AssociatedObjectType: "VirtualMachine",
+	}
+
+	for {
+		lst, _, err := s.client.Transactions.Filter(ctx, opt)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// transactions are listed from the newest to the oldest one
+		trxs := make([]Transaction, 0, len(lst))
+		for i := len(lst) - 1; i >= 0; i-- {
+			trxs = append(trxs, lst[i])
+		}
+
+		// the transactions following a failed one are cancelled
+		var pending, cancelled *Transaction
+		for i := range trxs {
+			switch {
+			case trxs[i].Failed():
+				return nil, trxs, &TransactionError{Transaction: &trxs[i]}
+			case trxs[i].Cancelled() && cancelled == nil:
+				cancelled = &trxs[i]
+			case trxs[i].Incomplete() && pending == nil:
+				pending = &trxs[i]
+			}
+		}
+
+		if pending != nil {
+			// the failures are found by the next listing
+			_, _, err = s.client.Transactions.Wait(ctx, pending.ID, opts)
+			var trxErr *TransactionError
+			if err != nil && !errors.As(err, &trxErr) {
+				return nil, trxs, err
+			}
+			continue
+		}
+
+		vm, _, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, trxs, err
+		}
+
+		if vm.Built && (!startup || vm.Booted) {
+			return vm, trxs, nil
+		}
+
+		if cancelled != nil {
+			return vm, trxs, &TransactionError{Transaction: cancelled}
+		}
+
+		// the next transactions of the build are not queued yet
+		timer := time.NewTimer(o.PollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return vm, trxs, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rollbackBuild releases the IP addresses of the VirtualMachine, so they are
+// free even if its destruction fails, and deletes it. The destruction is
+// confirmed by its transaction, or by the VirtualMachine being gone when the
+// transaction is not found.
+func (s *VirtualMachinesServiceOp) rollbackBuild(ctx context.Context, id int, opts *TransactionWaitOptions) error {
+	joins, _, err := s.client.IPAddresses.List(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, join := range joins {
+		if _, _, err := s.client.VirtualMachineActions.UnAssignIPAddress(ctx, id, join.ID, nil); err != nil {
+			return err
+		}
+	}
+
+	trx, _, err := s.Delete(ctx, id, nil)
+	switch {
+	case errors.Is(err, ErrTransactionNotFound):
+		return s.waitDeleted(ctx, id, opts)
+	case err != nil:
+		return err
+	}
+
+	_, _, err = s.client.Transactions.Wait(ctx, trx.ID, opts)
+	return err
+}
+
+// waitDeleted polls the VirtualMachine every PollInterval of opts until the
+// OnApp API doesn't find it.
+func (s *VirtualMachinesServiceOp) waitDeleted(ctx context.Context, id int, opts *TransactionWaitOptions) error {
+	o := opts.withDefaults()
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+
+	for {
+		_, _, err := s.Get(ctx, id)
+		switch {
+		case IsNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		timer := time.NewTimer(o.PollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("waiting for the deletion of virtual machine %d: %w", id, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
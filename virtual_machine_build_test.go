@@ -0,0 +1,109 @@
+package onappgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualMachines_CreateAndWait_lateTransactions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/virtual_machines.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"virtual_machine":{"id":1}}`)
+	})
+
+	// the build is queued after the first listing of the transactions
+	listings := 0
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		listings++
+		if listings == 1 {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+
+		fmt.Fprint(w, `[
+			{"transaction":{"id":2,"associated_object_id":1,"associated_object_type":"VirtualMachine","action":"startup_virtual_machine","status":"complete"}},
+			{"transaction":{"id":1,"associated_object_id":1,"associated_object_type":"VirtualMachine","action":"build_disk","status":"complete"}}
+		]`)
+	})
+
+	gets := 0
+	mux.HandleFunc("/virtual_machines/1.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			t.Error("virtual machine deleted while building")
+			return
+		}
+
+		gets++
+		built := gets > 1
+		fmt.Fprintf(w, `{"virtual_machine":{"id":1,"built":%t,"booted":%t,"initial_root_password":"secret"}}`, built, built)
+	})
+
+	build, _, err := client.VirtualMachines.CreateAndWait(ctx, &VirtualMachineCreateRequest{
+		Hostname:                      "web",
+		RequiredVirtualMachineStartup: true,
+	}, &VirtualMachineBuildOptions{Wait: testWaitOptions, Rollback: true})
+	require.NoError(t, err)
+	require.True(t, build.VirtualMachine.Booted)
+	require.Equal(t, "secret", build.InitialRootPassword)
+	require.Len(t, build.Transactions, 2)
+	require.Equal(t, "build_disk", build.Transactions[0].Action)
+}
+
+func TestVirtualMachines_CreateAndWait_rollbackWithoutTransaction(t *testing.T) {
+	for _, gone := range []bool{true, false} {
+		t.Run(fmt.Sprintf("gone=%t", gone), func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/virtual_machines.json", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"virtual_machine":{"id":1}}`)
+			})
+			mux.HandleFunc("/virtual_machines/1/ip_addresses.json", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `[]`)
+			})
+
+			// the transaction of the destruction is not listed
+			mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `[
+					{"transaction":{"id":1,"associated_object_id":1,"associated_object_type":"VirtualMachine","action":"build_disk","status":"failed"}}
+				]`)
+			})
+
+			deleted := false
+			mux.HandleFunc("/virtual_machines/1.json", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					deleted = true
+					return
+				}
+
+				if deleted && gone {
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(w, `{"errors":["not found"]}`)
+					return
+				}
+				fmt.Fprint(w, `{"virtual_machine":{"id":1}}`)
+			})
+
+			opts := *testWaitOptions
+			opts.Timeout = 50 * time.Millisecond
+			_, _, err := client.VirtualMachines.CreateAndWait(ctx, &VirtualMachineCreateRequest{Hostname: "web"},
+				&VirtualMachineBuildOptions{Wait: &opts, Rollback: true})
+
+			var buildErr *BuildError
+			require.ErrorAs(t, err, &buildErr)
+			require.True(t, deleted)
+			require.Equal(t, gone, buildErr.RolledBack)
+			if !gone {
+				require.ErrorIs(t, buildErr.RollbackErr, context.DeadlineExceeded)
+			}
+		})
+	}
+}
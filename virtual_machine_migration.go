@@ -0,0 +1,331 @@
+package onappgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+// MigrationMode selects how a VirtualMachine is moved to another compute
+// resource
+type MigrationMode string
+
+// Modes of the migration
+const (
+	// MigrationAuto hot-migrates the VirtualMachine when it can be, see
+	// VirtualMachineActionsService.CanHotMigrate, and cold-migrates it
+	// otherwise
+	MigrationAuto MigrationMode = ""
+
+	// MigrationHot moves the running VirtualMachine without stopping it
+	MigrationHot MigrationMode = "hot"
+
+	// MigrationCold stops the VirtualMachine if it runs, moves it and starts
+	// it up again
+	MigrationCold MigrationMode = "cold"
+)
+
+// VirtualMachineMigrateRequest represents a request to migrate a VirtualMachine
+type VirtualMachineMigrateRequest struct {
+	// Destination is the ID of the compute resource
+	Destination int
+
+	Mode MigrationMode
+
+	// DataStoreID moves the disks to another data store as well, zero keeps
+	// them where they are
+	DataStoreID int
+
+	// ColdMigrateOnRollback cold-migrates the VirtualMachine when its hot
+	// migration fails
+	ColdMigrateOnRollback bool
+
+	// Wait are the options of the transactions waited for before moving
+	// the disks
+	Wait *TransactionWaitOptions
+}
+
+func (d VirtualMachineMigrateRequest) String() string {
+	return godo.Stringify(d)
+}
+
+type virtualMachineMigration struct {
+	Destination           int  `json:"destination"`
+	HotMigrate            bool `json:"hot_migrate"`
+	ColdMigrateOnRollback bool `json:"cold_migrate_on_rollback,omitempty"`
+}
+
+type rootVirtualMachineMigration struct {
+	Migration *virtualMachineMigration `json:"virtual_machine"`
+}
+
+type diskMigration struct {
+	DataStoreID int `json:"data_store_id"`
+}
+
+type rootDiskMigration struct {
+	Migration *diskMigration `json:"disk"`
+}
+
+// VirtualMachineMigration is the migration started by
+// VirtualMachineActionsService.Migrate
+type VirtualMachineMigration struct {
+	// Mode of the migration, hot or cold
+	Mode MigrationMode
+
+	// Transactions of the migration from the oldest to the newest one, the
+	// migration is done when the last one completes. They are nil in the
+	// dry-run mode.
+	Transactions []Transaction
+}
+
+// MigrationError is returned when a VirtualMachine can't be migrated in the
+// requested mode
+type MigrationError struct {
+	VirtualMachineID int
+	Mode             MigrationMode
+
+	// Reasons preventing the migration
+	Reasons []string
+}
+
+func (e *MigrationError) Error() string {
+	return fmt.Sprintf("onapp: virtual machine %d can't be %s-migrated: %s",
+		e.VirtualMachineID, e.Mode, strings.Join(e.Reasons, "; "))
+}
+
+// CanHotMigrate reports why the VirtualMachine can't be hot-migrated as
+// requested, the reasons are empty when it can. The mode of the request is
+// ignored.
+func (s *VirtualMachineActionsServiceOp) CanHotMigrate(ctx context.Context, id int, migrateRequest *VirtualMachineMigrateRequest) ([]string, *Response, error) {
+	vm, hv, resp, err := s.migrationTargets(ctx, id, migrateRequest)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	reasons := destinationBlockers(hv, vm)
+	reasons = append(reasons, hotMigrationBlockers(vm, migrateRequest)...)
+
+	return reasons, resp, nil
+}
+
+// Migrate moves a VirtualMachine to another compute resource, and its disks to
+// another data store if requested. A MigrationError is returned without
+// starting the migration when the VirtualMachine can't be migrated in the
+// requested mode. The VirtualMachine is locked while it is migrated, so the
+// disks are moved one after the other once the migration of the
+// VirtualMachine is done: Migrate only returns then. If it fails, the
+// migration is returned with the transactions started so far.
+func (s *VirtualMachineActionsServiceOp) Migrate(ctx context.Context, id int, migrateRequest *VirtualMachineMigrateRequest) (*VirtualMachineMigration, *Response, error) {
+	vm, hv, resp, err := s.migrationTargets(ctx, id, migrateRequest)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	hotBlockers := hotMigrationBlockers(vm, migrateRequest)
+
+	mode := migrateRequest.Mode
+	if mode == MigrationAuto {
+		mode = MigrationHot
+		if len(hotBlockers) > 0 {
+			mode = MigrationCold
+		}
+	}
+
+	reasons := destinationBlockers(hv, vm)
+	if mode == MigrationHot {
+		reasons = append(reasons, hotBlockers...)
+	}
+	if len(reasons) > 0 {
+		return nil, resp, &MigrationError{VirtualMachineID: id, Mode: mode, Reasons: reasons}
+	}
+
+	var moved []Disk
+	if migrateRequest.DataStoreID != 0 {
+		disks, resp, err := s.client.VirtualMachines.Disks(ctx, id, nil)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, disk := range disks {
+			if disk.DataStoreID != migrateRequest.DataStoreID {
+				moved = append(moved, disk)
+			}
+		}
+	}
+
+	// the transactions of the migration are the ones newer than the last one
+	last, resp, err := s.client.Transactions.LastID(ctx, virtualMachineTransactions(id))
+	if err != nil {
+		return nil, resp, err
+	}
+
+	path := fmt.Sprintf("%s/%d/migration%s", virtualMachineBasePath, id, apiFormat)
+	root := &rootVirtualMachineMigration{
+		Migration: &virtualMachineMigration{
+			Destination:           migrateRequest.Destination,
+			HotMigrate:            mode == MigrationHot,
+			ColdMigrateOnRollback: migrateRequest.ColdMigrateOnRollback,
+		},
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err = s.client.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	first := resp
+
+	migration := &VirtualMachineMigration{Mode: mode}
+	for _, disk := range moved {
+		if !first.DryRun {
+			// the VirtualMachine is locked until the transactions are done,
+			// whoever made them
+			if _, err := s.client.Transactions.WaitAfter(ctx, virtualMachineTransactions(id), last, migrateRequest.Wait); err != nil {
+				migration.Transactions, _ = s.migrationTransactions(ctx, id, last, first)
+				return migration, resp, err
+			}
+		}
+
+		path := fmt.Sprintf("%s/%d/disks/%d/migrate%s", virtualMachineBasePath, id, disk.ID, apiFormat)
+		root := &rootDiskMigration{
+			Migration: &diskMigration{DataStoreID: migrateRequest.DataStoreID},
+		}
+
+		req, err := s.client.NewRequest(ctx, http.MethodPost, path, root)
+		if err != nil {
+			return migration, nil, err
+		}
+
+		resp, err = s.client.Do(ctx, req, nil)
+		if err != nil {
+			if !first.DryRun {
+				migration.Transactions, _ = s.migrationTransactions(ctx, id, last, first)
+			}
+			return migration, resp, err
+		}
+	}
+
+	if resp.DryRun {
+		return migration, resp, nil
+	}
+
+	migration.Transactions, err = s.migrationTransactions(ctx, id, last, first)
+	return migration, resp, err
+}
+
+// migrationTargets validates the request, and returns the VirtualMachine and
+// its destination.
+func (s *VirtualMachineActionsServiceOp) migrationTargets(ctx context.Context, id int, migrateRequest *VirtualMachineMigrateRequest) (*VirtualMachine, *Hypervisor, *Response, error) {
+	if id < 1 {
+		return nil, nil, nil, godo.NewArgError("id", "cannot be less than 1")
+	}
+
+	if migrateRequest == nil {
+		return nil, nil, nil, godo.NewArgError("migrateRequest", "cannot be nil")
+	}
+
+	if migrateRequest.Destination < 1 {
+		return nil, nil, nil, godo.NewArgError("migrateRequest.Destination", "cannot be less than 1")
+	}
+
+	switch migrateRequest.Mode {
+	case MigrationAuto, MigrationHot, MigrationCold:
+	default:
+		return nil, nil, nil, godo.NewArgError("migrateRequest.Mode", fmt.Sprintf("unknown mode %q", migrateRequest.Mode))
+	}
+
+	vm, resp, err := s.client.VirtualMachines.Get(ctx, id)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	if vm.HypervisorID == migrateRequest.Destination {
+		return nil, nil, resp, godo.NewArgError("migrateRequest.Destination", "is the current compute resource")
+	}
+
+	hv, resp, err := s.client.Hypervisors.Get(ctx, migrateRequest.Destination)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return vm, hv, resp, nil
+}
+
+// destinationBlockers returns the reasons preventing any migration to the
+// compute resource.
+func destinationBlockers(hv *Hypervisor, vm *VirtualMachine) []string {
+	var reasons []string
+	if !hv.Online {
+		reasons = append(reasons, fmt.Sprintf("compute resource %d is offline", hv.ID))
+	}
+	if !hv.Enabled {
+		reasons = append(reasons, fmt.Sprintf("compute resource %d is disabled", hv.ID))
+	}
+	if hv.FreeMemory > 0 && hv.FreeMemory < vm.Memory {
+		reasons = append(reasons, fmt.Sprintf("compute resource %d has %d MB of free memory, %d MB are needed",
+			hv.ID, hv.FreeMemory, vm.Memory))
+	}
+
+	return reasons
+}
+
+// hotMigrationBlockers returns the reasons preventing the hot migration of the
+// VirtualMachine.
+func hotMigrationBlockers(vm *VirtualMachine, migrateRequest *VirtualMachineMigrateRequest) []string {
+	var reasons []string
+	if !vm.Booted {
+		reasons = append(reasons, "it is not running")
+	}
+	if !vm.AllowedHotMigrate {
+		reasons = append(reasons, "its template does not allow hot migration")
+	}
+	if vm.Locked {
+		reasons = append(reasons, "it is locked")
+	}
+	if migrateRequest.DataStoreID != 0 {
+		reasons = append(reasons, "its disks are moved to another data store")
+	}
+
+	return reasons
+}
+
+// migrationTransactions returns the transactions of the VirtualMachine newer
+// than the after one which were created since the migration request of resp
+// by the user of the request, from the oldest to the newest one. See
+// requestTransaction for the matching of the actor, ErrTransactionNotFound is
+// returned when there are none.
+func (s *VirtualMachineActionsServiceOp) migrationTransactions(ctx context.Context, id int, after int, resp *Response) ([]Transaction, error) {
+	opt := &TransactionListOptions{
+		ListOptions:          ListOptions{PerPage: searchTransactions},
+		AssociatedObjectID:   id,
+		AssociatedObjectType: "VirtualMachine",
+		Since:                resp.serverStartedAt(),
+	}
+
+	lst, _, err := s.client.Transactions.Filter(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// transactions are listed from the newest to the oldest one
+	var own []Transaction
+	for i := len(lst) - 1; i >= 0; i-- {
+		if lst[i].ID > after && resp.triggered(&lst[i]) {
+			own = append(own, lst[i])
+		}
+	}
+
+	if len(own) == 0 {
+		return nil, ErrTransactionNotFound
+	}
+
+	return own, nil
+}
@@ -0,0 +1,46 @@
+package onappgo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualMachines_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/virtual_machines/1.json", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"virtual_machine":{"id":1,"booted":true,"cpus":1,"memory":1024,"hot_add_cpu":"1","hot_add_memory":"1","allowed_hot_migrate":true}}`)
+		case http.MethodPut:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	// the resize could be made without reboot, but the Control Panel reboots
+	mux.HandleFunc("/transactions.json", func(w http.ResponseWriter, r *http.Request) {
+		// the last transaction before the edit is an older resize
+		if r.URL.Query().Get("per_page") == "1" {
+			fmt.Fprintf(w, `[{"transaction":{"id":4,"actor":"%s","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"resize_vm_without_reboot"}}]`, email)
+			return
+		}
+
+		fmt.Fprintf(w, `[
+			{"transaction":{"id":5,"actor":"%[1]s","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"resize_vm"}},
+			{"transaction":{"id":4,"actor":"%[1]s","associated_object_id":1,"associated_object_type":"VirtualMachine","action":"resize_vm_without_reboot"}}
+		]`, email)
+	})
+
+	editRequest := &VirtualMachineEditRequest{Memory: 2048}
+	require.False(t, (&VirtualMachine{Booted: true, Memory: 1024, HotAddMemory: "1"}).ResizeRequiresReboot(editRequest))
+
+	edit, _, err := client.VirtualMachines.Edit(ctx, 1, editRequest)
+	require.NoError(t, err)
+	require.Equal(t, 5, edit.Transaction.ID)
+	require.True(t, edit.RebootRequired)
+	require.False(t, edit.MayHotMigrate)
+}